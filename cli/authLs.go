@@ -0,0 +1,38 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"text/tabwriter"
+
+	"github.com/opctl/opctl/cli/internal/nodeprovider"
+)
+
+// authLs implements "auth ls" command
+func authLs(
+	ctx context.Context,
+	nodeProvider nodeprovider.NodeProvider,
+) error {
+	node, err := nodeProvider.CreateNodeIfNotExists(ctx)
+	if err != nil {
+		return err
+	}
+
+	auths, err := node.ListAuths(ctx)
+	if err != nil {
+		return err
+	}
+
+	_tabWriter := new(tabwriter.Writer)
+	defer _tabWriter.Flush()
+	_tabWriter.Init(os.Stdout, 0, 8, 1, '\t', 0)
+
+	fmt.Fprintln(_tabWriter, "RESOURCES\tUSERNAME")
+
+	for _, auth := range auths {
+		fmt.Fprintf(_tabWriter, "%v\t%v\n", auth.Resources, auth.Username)
+	}
+
+	return nil
+}
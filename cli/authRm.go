@@ -0,0 +1,27 @@
+package main
+
+import (
+	"context"
+
+	"github.com/opctl/opctl/cli/internal/nodeprovider"
+	"github.com/opctl/opctl/sdks/go/model"
+)
+
+// authRm implements "auth rm" command
+func authRm(
+	ctx context.Context,
+	nodeProvider nodeprovider.NodeProvider,
+	resources string,
+) error {
+	node, err := nodeProvider.CreateNodeIfNotExists(ctx)
+	if err != nil {
+		return err
+	}
+
+	return node.RemoveAuth(
+		ctx,
+		model.RemoveAuthReq{
+			Resources: resources,
+		},
+	)
+}
@@ -14,6 +14,7 @@ import (
 	"github.com/opctl/opctl/cli/internal/nodeprovider/local"
 	"github.com/opctl/opctl/sdks/go/model"
 	"github.com/opctl/opctl/sdks/go/opspec"
+	"github.com/opctl/opctl/sdks/go/opspec/linter"
 )
 
 var testModeEnvVar = "OPCTL_TEST_MODE"
@@ -87,10 +88,30 @@ func newCli(
 		},
 	)
 
+	nodePassphrase := cli.String(
+		mow.StringOpt{
+			Desc:      "Passphrase the key auth is encrypted at rest w/ is derived from; if unset, a key file in the data dir is used",
+			EnvVar:    "OPCTL_NODE_PASSPHRASE",
+			HideValue: true,
+			Name:      "node-passphrase",
+		},
+	)
+
+	previousNodePassphrase := cli.String(
+		mow.StringOpt{
+			Desc:      "Previous value of node-passphrase; set when changing node-passphrase so auth can be re-encrypted",
+			EnvVar:    "OPCTL_NODE_PREVIOUS_PASSPHRASE",
+			HideValue: true,
+			Name:      "previous-node-passphrase",
+		},
+	)
+
 	nodeCreateOpts := local.NodeCreateOpts{
-		ContainerRuntime: *containerRuntime,
-		DataDir:          *dataDir,
-		ListenAddress:    *listenAddress,
+		ContainerRuntime:   *containerRuntime,
+		DataDir:            *dataDir,
+		ListenAddress:      *listenAddress,
+		Passphrase:         *nodePassphrase,
+		PreviousPassphrase: *previousNodePassphrase,
 	}
 
 	nodeProvider := local.New(
@@ -138,6 +159,33 @@ func newCli(
 				)
 			}
 		})
+
+		authCmd.Command("ls", "List auth; passwords are never listed", func(lsCmd *mow.Cmd) {
+			lsCmd.Action = func() {
+				exitWith(
+					"",
+					authLs(
+						ctx,
+						nodeProvider,
+					),
+				)
+			}
+		})
+
+		authCmd.Command("rm", "Remove auth", func(rmCmd *mow.Cmd) {
+			resources := rmCmd.StringArg("RESOURCES", "", "Resources of the auth to remove, exactly as added")
+
+			rmCmd.Action = func() {
+				exitWith(
+					"",
+					authRm(
+						ctx,
+						nodeProvider,
+						*resources,
+					),
+				)
+			}
+		})
 	})
 
 	cli.Command("events", "Stream events", func(eventsCmd *mow.Cmd) {
@@ -189,6 +237,18 @@ func newCli(
 				exitWith("", nodeProvider.KillNodeIfExists(""))
 			}
 		})
+
+		nodeCmd.Command("rotate-key", "Rotates the key auth is encrypted at rest w/; kills the node so auth is re-encrypted when it's next created", func(rotateKeyCmd *mow.Cmd) {
+			rotateKeyCmd.Action = func() {
+				exitWith(
+					"node key rotated",
+					nodeRotateKey(
+						nodeCreateOpts,
+						nodeProvider,
+					),
+				)
+			}
+		})
 	})
 
 	cli.Command("op", "Manage ops", func(opCmd *mow.Cmd) {
@@ -202,6 +262,49 @@ func newCli(
 			node,
 		)
 
+		opCmd.Command("cache", "Manage cached ops", func(cacheCmd *mow.Cmd) {
+			cacheCmd.Command("ls", "List cached ops", func(lsCmd *mow.Cmd) {
+				lsCmd.Action = func() {
+					exitWith(
+						"",
+						opCacheLs(
+							*dataDir,
+						),
+					)
+				}
+			})
+
+			cacheCmd.Command("prune", "Remove incomplete, old, or unused cached ops", func(pruneCmd *mow.Cmd) {
+				olderThan := pruneCmd.StringOpt("older-than", "", "Also remove ops pulled longer ago than this duration i.e. `720h`")
+				unusedFor := pruneCmd.StringOpt("unused-for", "", "Also remove ops unused for longer than this duration i.e. `168h`")
+
+				pruneCmd.Action = func() {
+					exitWith(
+						"",
+						opCachePrune(
+							*dataDir,
+							*olderThan,
+							*unusedFor,
+						),
+					)
+				}
+			})
+
+			cacheCmd.Command("rm", "Remove a cached op", func(rmCmd *mow.Cmd) {
+				opRef := rmCmd.StringArg("OP_REF", "", "Op reference (either `host/path/repo#version` as listed by `op cache ls` or `host/path/repo#version/path`)")
+
+				rmCmd.Action = func() {
+					exitWith(
+						"",
+						opCacheRm(
+							*dataDir,
+							*opRef,
+						),
+					)
+				}
+			})
+		})
+
 		opCmd.Command("create", "Create an op", func(createCmd *mow.Cmd) {
 			path := createCmd.StringOpt("path", opspec.DotOpspecDirName, "Path the op will be created at")
 			description := createCmd.StringOpt("d description", "", "Op description")
@@ -219,6 +322,61 @@ func newCli(
 			}
 		})
 
+		opCmd.Command("fmt", "Format an op", func(fmtCmd *mow.Cmd) {
+			isCheck := fmtCmd.BoolOpt("check", false, "Check the op is formatted instead of formatting it; exits non zero if it isn't")
+			opRef := fmtCmd.StringArg("OP_REF", "", "Op reference (either `relative/path` or `/absolute/path`; remote refs i.e. `host/path/repo#tag/path` can only be checked)")
+
+			fmtCmd.Action = func() {
+				successMessage := fmt.Sprintf("%v formatted", *opRef)
+				if *isCheck {
+					successMessage = fmt.Sprintf("%v is formatted", *opRef)
+				}
+
+				exitWith(
+					successMessage,
+					opFmt(
+						ctx,
+						dataResolver,
+						*opRef,
+						*isCheck,
+					),
+				)
+			}
+		})
+
+		opCmd.Command("graph", "Export the call graph of an op or a past run", func(graphCmd *mow.Cmd) {
+			graphCmd.Spec = "[--format] [-r] (--run | OP_REF)"
+			format := graphCmd.StringOpt("format", "dot", "Format of the graph (either `dot` or `mermaid`)")
+			isRecursive := graphCmd.BoolOpt("r recursive", false, "Also graph all child ops reachable from the op")
+			runID := graphCmd.StringOpt("run", "", "Id of a run to graph (w/ outcomes & durations) instead of an op")
+			opRef := graphCmd.StringArg("OP_REF", "", "Op reference (either `relative/path`, `/absolute/path`, `host/path/repo#tag`, or `host/path/repo#tag/path`)")
+
+			graphCmd.Action = func() {
+				if *runID != "" {
+					exitWith(
+						"",
+						opGraphRun(
+							ctx,
+							nodeProvider,
+							*runID,
+							*format,
+						),
+					)
+				}
+
+				exitWith(
+					"",
+					opGraph(
+						ctx,
+						dataResolver,
+						*opRef,
+						*format,
+						*isRecursive,
+					),
+				)
+			}
+		})
+
 		opCmd.Command("install", "Install an op", func(installCmd *mow.Cmd) {
 			path := installCmd.StringOpt("path", opspec.DotOpspecDirName, "Path the op will be installed at")
 			opRef := installCmd.StringArg("OP_REF", "", "Op reference (either `relative/path`, `/absolute/path`, `host/path/repo#tag`, or `host/path/repo#tag/path`)")
@@ -259,8 +417,75 @@ func newCli(
 			}
 		})
 
+		opCmd.Command("lint", "Lint an op", func(lintCmd *mow.Cmd) {
+			configPath := lintCmd.StringOpt("config", filepath.Join(opspec.DotOpspecDirName, linter.ConfigFileName), "Read in a lint config file in yml format")
+			opRef := lintCmd.StringArg("OP_REF", "", "Op reference (either `relative/path`, `/absolute/path`, `host/path/repo#tag`, or `host/path/repo#tag/path`)")
+
+			lintCmd.Action = func() {
+				exitWith(
+					fmt.Sprintf("%v passed lint", *opRef),
+					opLint(
+						ctx,
+						cliOutput,
+						dataResolver,
+						*configPath,
+						*opRef,
+					),
+				)
+			}
+		})
+
+		opCmd.Command("publish", "Publish an op to an OCI registry", func(publishCmd *mow.Cmd) {
+			opRef := publishCmd.StringArg("OP_REF", "", "Op reference (either `relative/path`, `/absolute/path`, `host/path/repo#tag`, or `host/path/repo#tag/path`)")
+			ociRef := publishCmd.StringArg("OCI_REF", "", "Reference the op will be published at (`oci://host/path:tag`)")
+			username := publishCmd.StringOpt("u username", "", "Username used to auth w/ the registry; defaults to creds of docker/podman config files")
+			password := publishCmd.StringOpt("p password", "", "Password used to auth w/ the registry")
+
+			publishCmd.Action = func() {
+				digest, err := opPublish(
+					ctx,
+					dataResolver,
+					*opRef,
+					*ociRef,
+					&model.Creds{
+						Username: *username,
+						Password: *password,
+					},
+				)
+				exitWith(
+					fmt.Sprintf("published %v (digest %v)", *ociRef, digest),
+					err,
+				)
+			}
+		})
+
+		opCmd.Command("sign", "Sign an op", func(signCmd *mow.Cmd) {
+			opRef := signCmd.StringArg("OP_REF", "", "Op reference (either `relative/path` or `/absolute/path`)")
+			keyPath := signCmd.String(
+				mow.StringOpt{
+					Desc:   "Path of the PEM encoded ed25519 private key to sign w/",
+					EnvVar: "OPCTL_SIGNING_KEY",
+					Name:   "k key",
+				},
+			)
+
+			signCmd.Action = func() {
+				digest, err := opSign(
+					ctx,
+					dataResolver,
+					*opRef,
+					*keyPath,
+				)
+				exitWith(
+					fmt.Sprintf("signed %v (digest %v)", *opRef, digest),
+					err,
+				)
+			}
+		})
+
 		opCmd.Command("validate", "Validate an op", func(validateCmd *mow.Cmd) {
 			opRef := validateCmd.StringArg("OP_REF", "", "Op reference (either `relative/path`, `/absolute/path`, `host/path/repo#tag`, or `host/path/repo#tag/path`)")
+			isRecursive := validateCmd.BoolOpt("r recursive", false, "Also validate all child ops reachable from the op")
 
 			validateCmd.Action = func() {
 				exitWith(
@@ -269,6 +494,7 @@ func newCli(
 						ctx,
 						dataResolver,
 						*opRef,
+						*isRecursive,
 					),
 				)
 			}
@@ -278,7 +504,9 @@ func newCli(
 	cli.Command("run", "Start and wait on an op", func(runCmd *mow.Cmd) {
 		args := runCmd.StringsOpt("a", []string{}, "Explicitly pass args to op in format `-a NAME1=VALUE1 -a NAME2=VALUE2`")
 		argFile := runCmd.StringOpt("arg-file", filepath.Join(opspec.DotOpspecDirName, "args.yml"), "Read in a file of args in yml format")
+		isDryRun := runCmd.BoolOpt("dry-run", false, "Print the container calls the op would make w/out making them")
 		opRef := runCmd.StringArg("OP_REF", "", "Op reference (either `relative/path`, `/absolute/path`, `host/path/repo#tag`, or `host/path/repo#tag/path`)")
+		refresh := runCmd.BoolOpt("refresh", false, "Re-pull ops referenced by git branch even if cached ones haven't expired")
 
 		runCmd.Action = func() {
 			exitWith(
@@ -288,9 +516,12 @@ func newCli(
 					cliOutput,
 					cliParamSatisfier,
 					nodeProvider,
+					*dataDir,
 					*args,
 					*argFile,
 					*opRef,
+					*refresh,
+					*isDryRun,
 				),
 			)
 		}
@@ -131,6 +131,8 @@ func (this _cliOutput) containerExited(event *model.Event) {
 	err := ""
 	if event.CallEnded.Error != nil {
 		err = fmt.Sprintf(" Error='%v'", event.CallEnded.Error.Message)
+	} else if event.CallEnded.IgnoredError != nil {
+		err = fmt.Sprintf(" IgnoredError='%v'", event.CallEnded.IgnoredError.Message)
 	}
 
 	imageRef := ""
@@ -185,6 +187,8 @@ func (this _cliOutput) opEnded(event *model.Event) {
 	err := ""
 	if event.CallEnded.Error != nil {
 		err = fmt.Sprintf(" Error='%v'", event.CallEnded.Error.Message)
+	} else if event.CallEnded.IgnoredError != nil {
+		err = fmt.Sprintf(" IgnoredError='%v'", event.CallEnded.IgnoredError.Message)
 	}
 	message := fmt.Sprintf(
 		"OpEnded Id='%v' OpRef='%v' Outcome='%v'%v Timestamp='%v'\n",
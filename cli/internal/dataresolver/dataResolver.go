@@ -3,6 +3,7 @@ package dataresolver
 import (
 	"context"
 	"fmt"
+	"net/url"
 	"os"
 	"path/filepath"
 	"strings"
@@ -55,6 +56,10 @@ func (dtr _dataResolver) Resolve(
 	)
 
 	domain := strings.Split(dataRef, "/")[0]
+	if refURL, err := url.Parse(dataRef); err == nil && refURL.Host != "" {
+		// i.e. tarball & oci refs
+		domain = refURL.Host
+	}
 
 	passwordDescription := fmt.Sprintf("Password for %s.", domain)
 	if domain == "github.com" {
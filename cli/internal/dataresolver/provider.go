@@ -0,0 +1,93 @@
+package dataresolver
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/opctl/opctl/sdks/go/data/fs"
+	"github.com/opctl/opctl/sdks/go/model"
+	"github.com/opctl/opctl/sdks/go/opspec"
+)
+
+// NewProvider returns a data provider which resolves remote data via dataResolver, so it's pulled by the node
+// (w/ creds prompted for on auth errors), & installs it within installPath so it's available on the local filesystem.
+//
+// Refs which aren't remote (i.e. relative paths) are skipped; they're expected to be resolved relative to their parent.
+func NewProvider(
+	dataResolver DataResolver,
+	installPath string,
+) model.DataProvider {
+	return _provider{
+		dataResolver: dataResolver,
+		installPath:  installPath,
+	}
+}
+
+type _provider struct {
+	dataResolver DataResolver
+	installPath  string
+}
+
+func (p _provider) Label() string {
+	return "opctl node"
+}
+
+func (p _provider) TryResolve(
+	ctx context.Context,
+	dataRef string,
+) (model.DataHandle, error) {
+	if !strings.Contains(dataRef, "#") && !strings.Contains(dataRef, "://") {
+		return nil, errors.New("skipped")
+	}
+
+	return ResolveLocal(ctx, p.dataResolver, dataRef, p.installPath)
+}
+
+// ResolveLocal resolves dataRef via dataResolver; data not available on the local filesystem
+// (i.e. resolved via a node) is installed within installPath & resolved from there.
+//
+// Repos of git refs w/ an op path (host/path/repo#tag/path) are installed whole so ops can reference their siblings.
+func ResolveLocal(
+	ctx context.Context,
+	dataResolver DataResolver,
+	dataRef string,
+	installPath string,
+) (model.DataHandle, error) {
+	installRef, opPath := dataRef, ""
+	if fragmentIndex := strings.Index(dataRef, "#"); fragmentIndex >= 0 {
+		if fragmentParts := strings.SplitN(dataRef[fragmentIndex+1:], "/", 2); len(fragmentParts) == 2 {
+			installRef = dataRef[:fragmentIndex+1] + fragmentParts[0]
+			opPath = fragmentParts[1]
+		}
+	}
+
+	installRefSHA256 := sha256.Sum256([]byte(installRef))
+	installedPath := filepath.Join(installPath, hex.EncodeToString(installRefSHA256[:]))
+
+	if _, err := os.Stat(installedPath); os.IsNotExist(err) {
+		handle, err := dataResolver.Resolve(ctx, installRef, nil)
+		if err != nil {
+			return nil, err
+		}
+
+		if handlePath := handle.Path(); handlePath != nil {
+			// already available locally
+			return fs.New(*handlePath).TryResolve(ctx, opPath)
+		}
+
+		if err := opspec.Install(ctx, installedPath, handle); err != nil {
+			// don't leave partial installs behind
+			os.RemoveAll(installedPath)
+			return nil, err
+		}
+	} else if err != nil {
+		return nil, err
+	}
+
+	return fs.New(installedPath).TryResolve(ctx, opPath)
+}
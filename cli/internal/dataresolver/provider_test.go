@@ -0,0 +1,79 @@
+package dataresolver
+
+import (
+	"context"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/opctl/opctl/sdks/go/model"
+	nodeFakes "github.com/opctl/opctl/sdks/go/node/fakes"
+)
+
+var _ = Context("provider", func() {
+	Context("TryResolve", func() {
+		Context("ref isn't remote", func() {
+			It("should return expected error", func() {
+				/* arrange */
+				objectUnderTest := NewProvider(
+					_dataResolver{
+						node: new(nodeFakes.FakeNode),
+					},
+					"dummyInstallPath",
+				)
+
+				/* act */
+				actualHandle, actualErr := objectUnderTest.TryResolve(context.Background(), "testdata/dummy-op")
+
+				/* assert */
+				Expect(actualHandle).To(BeNil())
+				Expect(actualErr).To(MatchError("skipped"))
+			})
+		})
+		Context("ref is remote", func() {
+			It("should install repo via node & return path of op", func() {
+				/* arrange */
+				providedRepoRef := "github.com/org/repo#1.0.0"
+
+				fakeNode := new(nodeFakes.FakeNode)
+				fakeNode.ListDescendantsReturns(
+					[]*model.DirEntry{
+						{Path: "/build/op.yml", Mode: 0644},
+						{Path: "/test/op.yml", Mode: 0644},
+					},
+					nil,
+				)
+				fakeNode.GetDataStub = func(ctx context.Context, req model.GetDataReq) (model.ReadSeekCloser, error) {
+					return os.Open("testdata/dummy-op/op.yml")
+				}
+
+				installPath, err := ioutil.TempDir("", "")
+				if err != nil {
+					panic(err)
+				}
+
+				objectUnderTest := NewProvider(
+					_dataResolver{
+						node: fakeNode,
+					},
+					installPath,
+				)
+
+				/* act */
+				actualHandle, actualErr := objectUnderTest.TryResolve(context.Background(), providedRepoRef+"/build")
+
+				/* assert */
+				Expect(actualErr).To(BeNil())
+				Expect(*actualHandle.Path()).To(HavePrefix(installPath))
+
+				_, actualReq := fakeNode.ListDescendantsArgsForCall(0)
+				Expect(actualReq.PkgRef).To(Equal(providedRepoRef))
+
+				// siblings are installed too
+				Expect(filepath.Join(*actualHandle.Path(), "../test/op.yml")).To(BeARegularFile())
+			})
+		})
+	})
+})
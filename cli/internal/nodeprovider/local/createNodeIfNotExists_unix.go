@@ -54,6 +54,8 @@ func (np nodeProvider) CreateNodeIfNotExists(ctx context.Context) (node.Node, er
 	nodeCmd.Env = []string{
 		fmt.Sprintf("HOME=%s", os.Getenv("HOME")),
 	}
+	nodeCmd.Env = append(nodeCmd.Env, np.passphraseEnv()...)
+	nodeCmd.Env = append(nodeCmd.Env, gitEnv()...)
 
 	// ensure node gets it's own process group
 	nodeCmd.SysProcAttr = &syscall.SysProcAttr{
@@ -52,6 +52,8 @@ func (np nodeProvider) CreateNodeIfNotExists(ctx context.Context) (node.Node, er
 	nodeCmd.Env = []string{
 		fmt.Sprintf("LOCALAPPDATA=%s", os.Getenv("LOCALAPPDATA")),
 	}
+	nodeCmd.Env = append(nodeCmd.Env, np.passphraseEnv()...)
+	nodeCmd.Env = append(nodeCmd.Env, gitEnv()...)
 
 	// ensure node gets it's own process group
 	nodeCmd.SysProcAttr = &syscall.SysProcAttr{
@@ -1,6 +1,9 @@
 package local
 
 import (
+	"fmt"
+	"os"
+
 	"github.com/golang-utils/lockfile"
 	"github.com/opctl/opctl/cli/internal/datadir"
 	"github.com/opctl/opctl/cli/internal/nodeprovider"
@@ -13,6 +16,10 @@ type NodeCreateOpts struct {
 	// ListenAddress sets the HOST:PORT on which the node will listen
 	ListenAddress    string
 	ContainerRuntime string
+	// Passphrase, if set, derives the key auth is encrypted at rest w/ instead of a key file
+	Passphrase string
+	// PreviousPassphrase, if set, allows decrypting auth encrypted prior to changing Passphrase
+	PreviousPassphrase string
 }
 
 // New returns an initialized "local" node provider
@@ -25,14 +32,43 @@ func New(
 	}
 
 	return nodeProvider{
-		dataDir:       dataDir,
-		listenAddress: opts.ListenAddress,
-		lockfile:      lockfile.New(),
+		dataDir:            dataDir,
+		listenAddress:      opts.ListenAddress,
+		lockfile:           lockfile.New(),
+		passphrase:         opts.Passphrase,
+		previousPassphrase: opts.PreviousPassphrase,
 	}
 }
 
 type nodeProvider struct {
-	dataDir       datadir.DataDir
-	listenAddress string
-	lockfile      lockfile.LockFile
+	dataDir            datadir.DataDir
+	listenAddress      string
+	lockfile           lockfile.LockFile
+	passphrase         string
+	previousPassphrase string
+}
+
+// passphraseEnv returns env passing passphrases to a node; they're intentionally not passed as args
+// since args are visible to other processes
+func (np nodeProvider) passphraseEnv() []string {
+	env := []string{}
+	if np.passphrase != "" {
+		env = append(env, fmt.Sprintf("OPCTL_NODE_PASSPHRASE=%s", np.passphrase))
+	}
+	if np.previousPassphrase != "" {
+		env = append(env, fmt.Sprintf("OPCTL_NODE_PREVIOUS_PASSPHRASE=%s", np.previousPassphrase))
+	}
+	return env
+}
+
+// gitEnv returns env git op refs are pulled w/ (ssh-agent, known_hosts, CA bundle, branch TTL) so a node pulls
+// w/ the same identity & trust as the CLI that created it
+func gitEnv() []string {
+	env := []string{}
+	for _, name := range []string{"SSH_AUTH_SOCK", "SSH_KNOWN_HOSTS", "GIT_SSL_CAINFO", "OPCTL_GIT_BRANCH_TTL"} {
+		if value, ok := os.LookupEnv(name); ok {
+			env = append(env, fmt.Sprintf("%s=%s", name, value))
+		}
+	}
+	return env
 }
@@ -2,6 +2,7 @@ package main
 
 import (
 	"context"
+	"path/filepath"
 
 	"github.com/opctl/opctl/cli/internal/datadir"
 	"github.com/opctl/opctl/cli/internal/nodeprovider/local"
@@ -9,6 +10,7 @@ import (
 	"github.com/opctl/opctl/sdks/go/node/core/containerruntime"
 	"github.com/opctl/opctl/sdks/go/node/core/containerruntime/docker"
 	"github.com/opctl/opctl/sdks/go/node/core/containerruntime/k8s"
+	"github.com/opctl/opctl/sdks/go/node/core/nodekey"
 )
 
 // node command
@@ -38,12 +40,30 @@ func node(
 		}
 	}
 
+	keyring, err := newNodeKeyring(nodeCreateOpts, dataDir.Path())
+	if err != nil {
+		return err
+	}
+
+	nodeCore, err := core.New(
+		ctx,
+		containerRuntime,
+		dataDir.Path(),
+		keyring,
+	)
+	if err != nil {
+		return err
+	}
+
+	if nodeCreateOpts.Passphrase == "" {
+		// auth was re-encrypted w/ the primary key by core.New; prior keys are no longer needed
+		if err := nodekey.PruneFile(nodeKeyFilePath(dataDir.Path())); err != nil {
+			return err
+		}
+	}
+
 	return newHTTPListener(
-		core.New(
-			ctx,
-			containerRuntime,
-			dataDir.Path(),
-		),
+		nodeCore,
 	).
 		listen(
 			ctx,
@@ -51,3 +71,31 @@ func node(
 		)
 
 }
+
+// nodeKeyFilePath returns the path of the key file of the node w/ dataDirPath
+func nodeKeyFilePath(
+	dataDirPath string,
+) string {
+	return filepath.Join(dataDirPath, "node.key")
+}
+
+// newNodeKeyring returns the keyring the node encrypts auth at rest w/;
+// derived from passphrases if set, otherwise loaded from the node key file
+func newNodeKeyring(
+	nodeCreateOpts local.NodeCreateOpts,
+	dataDirPath string,
+) (*nodekey.Keyring, error) {
+	if nodeCreateOpts.Passphrase == "" {
+		return nodekey.LoadFile(nodeKeyFilePath(dataDirPath))
+	}
+
+	passphrases := []string{nodeCreateOpts.Passphrase}
+	if nodeCreateOpts.PreviousPassphrase != "" {
+		passphrases = append(passphrases, nodeCreateOpts.PreviousPassphrase)
+	}
+
+	return nodekey.FromPassphrases(
+		filepath.Join(dataDirPath, "node.salt"),
+		passphrases...,
+	)
+}
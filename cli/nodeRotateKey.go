@@ -0,0 +1,32 @@
+package main
+
+import (
+	"errors"
+
+	"github.com/opctl/opctl/cli/internal/datadir"
+	"github.com/opctl/opctl/cli/internal/nodeprovider"
+	"github.com/opctl/opctl/cli/internal/nodeprovider/local"
+	"github.com/opctl/opctl/sdks/go/node/core/nodekey"
+)
+
+// nodeRotateKey implements "node rotate-key" command
+func nodeRotateKey(
+	nodeCreateOpts local.NodeCreateOpts,
+	nodeProvider nodeprovider.NodeProvider,
+) error {
+	if nodeCreateOpts.Passphrase != "" {
+		return errors.New("node key is derived from node-passphrase; to rotate it, set previous-node-passphrase to node-passphrase, set node-passphrase to a new passphrase, then kill the node")
+	}
+
+	dataDir, err := datadir.New(nodeCreateOpts.DataDir)
+	if err != nil {
+		return err
+	}
+
+	// kill the node so it loads the rotated key file (& re-encrypts auth) when next created
+	if err := nodeProvider.KillNodeIfExists(""); err != nil {
+		return err
+	}
+
+	return nodekey.RotateFile(nodeKeyFilePath(dataDir.Path()))
+}
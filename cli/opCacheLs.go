@@ -0,0 +1,59 @@
+package main
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"text/tabwriter"
+	"time"
+
+	"github.com/opctl/opctl/sdks/go/data/cache"
+)
+
+// opCacheLs implements "op cache ls" command
+func opCacheLs(
+	dataDir string,
+) error {
+	entries, err := cache.List(filepath.Join(dataDir, "ops"))
+	if err != nil {
+		return err
+	}
+
+	_tabWriter := new(tabwriter.Writer)
+	defer _tabWriter.Flush()
+	_tabWriter.Init(os.Stdout, 0, 8, 1, '\t', 0)
+
+	fmt.Fprintln(_tabWriter, "REF\tKIND\tCOMMIT\tPULLED\tUSED\tSTATUS")
+
+	for _, entry := range entries {
+		kind := entry.Kind
+		if kind == "" {
+			kind = "-"
+		}
+
+		commit := entry.Commit
+		if commit == "" {
+			commit = "-"
+		} else if len(commit) > 12 {
+			commit = commit[:12]
+		}
+
+		status := "complete"
+		if !entry.IsComplete {
+			status = "incomplete"
+		}
+
+		fmt.Fprintf(
+			_tabWriter,
+			"%v\t%v\t%v\t%v\t%v\t%v\n",
+			entry.Ref,
+			kind,
+			commit,
+			entry.PulledAt.Local().Format(time.RFC3339),
+			entry.UsedAt.Local().Format(time.RFC3339),
+			status,
+		)
+	}
+
+	return nil
+}
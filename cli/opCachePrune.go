@@ -0,0 +1,71 @@
+package main
+
+import (
+	"fmt"
+	"path/filepath"
+	"time"
+
+	"github.com/opctl/opctl/sdks/go/data/cache"
+	"github.com/pkg/errors"
+)
+
+// opCachePrune implements "op cache prune" command
+//
+// Incomplete ops & abandoned pulls are always pruned; others only if pulled before olderThan or unused for unusedFor (if not empty).
+// Ops cached before usage was recorded are never pruned as unused.
+func opCachePrune(
+	dataDir string,
+	olderThan string,
+	unusedFor string,
+) error {
+	now := time.Now()
+
+	var pulledBefore, usedBefore time.Time
+	if olderThan != "" {
+		duration, err := time.ParseDuration(olderThan)
+		if err != nil {
+			return errors.Wrap(err, "invalid older-than")
+		}
+		pulledBefore = now.Add(-duration)
+	}
+
+	if unusedFor != "" {
+		duration, err := time.ParseDuration(unusedFor)
+		if err != nil {
+			return errors.Wrap(err, "invalid unused-for")
+		}
+		usedBefore = now.Add(-duration)
+	}
+
+	cachePath := filepath.Join(dataDir, "ops")
+	entries, err := cache.List(cachePath)
+	if err != nil {
+		return err
+	}
+
+	for _, entry := range entries {
+		// data cached before usage was recorded may be in use so isn't pruned as unused
+		if entry.IsComplete &&
+			!entry.PulledAt.Before(pulledBefore) &&
+			(!entry.IsUsageKnown || !entry.UsedAt.Before(usedBefore)) {
+			continue
+		}
+
+		if err := cache.Remove(cachePath, entry.Ref); err != nil {
+			return err
+		}
+
+		fmt.Printf("removed %v\n", entry.Ref)
+	}
+
+	removedPulls, err := cache.PruneAbandonedPulls(cachePath)
+	if err != nil {
+		return err
+	}
+
+	for _, removedPull := range removedPulls {
+		fmt.Printf("removed %v\n", removedPull)
+	}
+
+	return nil
+}
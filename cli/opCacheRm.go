@@ -0,0 +1,33 @@
+package main
+
+import (
+	"path"
+	"path/filepath"
+	"strings"
+
+	"github.com/opctl/opctl/sdks/go/data/cache"
+	"github.com/opctl/opctl/sdks/go/data/git"
+)
+
+// opCacheRm implements "op cache rm" command
+//
+// opRef MAY be a ref listed by "op cache ls" or a git ref.
+func opCacheRm(
+	dataDir string,
+	opRef string,
+) error {
+	cacheRef := opRef
+	if !strings.Contains(path.Base(filepath.ToSlash(opRef)), "#") {
+		// not a cache ref; i.e. a git ref w/ op path
+		var err error
+		cacheRef, err = git.ToCacheRef(opRef)
+		if err != nil {
+			return err
+		}
+	}
+
+	return cache.Remove(
+		filepath.Join(dataDir, "ops"),
+		cacheRef,
+	)
+}
@@ -0,0 +1,79 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+
+	"github.com/opctl/opctl/cli/internal/dataresolver"
+	"github.com/opctl/opctl/sdks/go/data/fs"
+	"github.com/opctl/opctl/sdks/go/opspec"
+	"github.com/opctl/opctl/sdks/go/opspec/opfile"
+)
+
+// opFmt implements "op fmt" command
+//
+// Only ops on the local filesystem are formatted; remote ops (which are resolved from the op cache,
+// where formatting them would alter cached & possibly signed data) can only be checked.
+func opFmt(
+	ctx context.Context,
+	dataResolver dataresolver.DataResolver,
+	opRef string,
+	isCheck bool,
+) error {
+	cwd, err := os.Getwd()
+	if err != nil {
+		return err
+	}
+
+	opDirHandle, err := fs.New(
+		filepath.Join(cwd, opspec.DotOpspecDirName),
+		cwd,
+	).TryResolve(
+		ctx,
+		opRef,
+	)
+	if err != nil || opDirHandle == nil {
+		if !isCheck {
+			return fmt.Errorf("unable to format %v: only ops on the local filesystem can be formatted; use --check to check remote ops", opRef)
+		}
+
+		opDirHandle, err = dataResolver.Resolve(
+			ctx,
+			opRef,
+			nil,
+		)
+		if err != nil {
+			return err
+		}
+	}
+
+	opPath := opDirHandle.Path()
+	if opPath == nil {
+		return fmt.Errorf("unable to format %v: op not available locally", opRef)
+	}
+
+	opFilePath := filepath.Join(*opPath, opfile.FileName)
+	opFileBytes, err := ioutil.ReadFile(opFilePath)
+	if err != nil {
+		return err
+	}
+
+	formattedBytes, err := opfile.Format(opFileBytes)
+	if err != nil {
+		return err
+	}
+
+	if bytes.Equal(opFileBytes, formattedBytes) {
+		return nil
+	}
+
+	if isCheck {
+		return fmt.Errorf("%v isn't formatted; run `opctl op fmt %v`", opFilePath, opRef)
+	}
+
+	return ioutil.WriteFile(opFilePath, formattedBytes, 0666)
+}
@@ -0,0 +1,127 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"time"
+
+	"github.com/opctl/opctl/cli/internal/dataresolver"
+	"github.com/opctl/opctl/cli/internal/nodeprovider"
+	"github.com/opctl/opctl/sdks/go/model"
+	"github.com/opctl/opctl/sdks/go/opspec/graph"
+)
+
+// graphRenderers are the renderers of graphs by format
+var graphRenderers = map[string]func(*graph.Graph) string{
+	"dot":     graph.DOT,
+	"mermaid": graph.Mermaid,
+}
+
+// runNotFoundTimeout is how long to wait for the events of a run before concluding it doesn't exist
+const runNotFoundTimeout = 5 * time.Second
+
+// opGraph implements "op graph" command
+func opGraph(
+	ctx context.Context,
+	dataResolver dataresolver.DataResolver,
+	opRef string,
+	format string,
+	isRecursive bool,
+) error {
+	render, ok := graphRenderers[format]
+	if !ok {
+		return fmt.Errorf("unsupported format '%v'; expected dot or mermaid", format)
+	}
+
+	// ops resolved via the node are installed here so they can be read from the filesystem
+	installPath, err := ioutil.TempDir("", "opctl-ops")
+	if err != nil {
+		return err
+	}
+	defer os.RemoveAll(installPath)
+
+	opDirHandle, err := dataresolver.ResolveLocal(
+		ctx,
+		dataResolver,
+		opRef,
+		installPath,
+	)
+	if err != nil {
+		return err
+	}
+
+	g, err := graph.FromOp(
+		ctx,
+		*opDirHandle.Path(),
+		isRecursive,
+		// child ops are pulled by the node
+		dataresolver.NewProvider(dataResolver, installPath),
+	)
+	if err != nil {
+		return err
+	}
+
+	fmt.Fprint(os.Stdout, render(g))
+	return nil
+}
+
+// opGraphRun implements "op graph --run" command; it waits for the run to end
+func opGraphRun(
+	ctx context.Context,
+	nodeProvider nodeprovider.NodeProvider,
+	rootCallID string,
+	format string,
+) error {
+	render, ok := graphRenderers[format]
+	if !ok {
+		return fmt.Errorf("unsupported format '%v'; expected dot or mermaid", format)
+	}
+
+	node, err := nodeProvider.CreateNodeIfNotExists(ctx)
+	if err != nil {
+		return err
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	eventChannel, err := node.GetEventStream(
+		ctx,
+		&model.GetEventStreamReq{
+			Filter: model.EventFilter{
+				Roots: []string{rootCallID},
+			},
+		},
+	)
+	if err != nil {
+		return err
+	}
+
+	events := []model.Event{}
+	notFoundTimer := time.NewTimer(runNotFoundTimeout)
+	defer notFoundTimer.Stop()
+
+	for {
+		select {
+		case <-notFoundTimer.C:
+			return fmt.Errorf("run '%v' not found", rootCallID)
+		case event, isEventChannelOpen := <-eventChannel:
+			if !isEventChannelOpen {
+				return errors.New("Connection to event stream lost")
+			}
+
+			if event.CallStarted != nil || event.CallEnded != nil {
+				notFoundTimer.Stop()
+				events = append(events, event)
+			}
+
+			if event.CallEnded != nil && event.CallEnded.Call.ID == rootCallID {
+				fmt.Fprint(os.Stdout, render(graph.FromRun(rootCallID, events)))
+				return nil
+			}
+		}
+	}
+}
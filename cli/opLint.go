@@ -0,0 +1,67 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"io/ioutil"
+
+	"github.com/opctl/opctl/cli/internal/clioutput"
+	"github.com/opctl/opctl/cli/internal/dataresolver"
+	"github.com/opctl/opctl/sdks/go/opspec/linter"
+	"github.com/opctl/opctl/sdks/go/opspec/opfile"
+)
+
+// opLint implements "op lint" command
+func opLint(
+	ctx context.Context,
+	cliOutput clioutput.CliOutput,
+	dataResolver dataresolver.DataResolver,
+	configPath string,
+	opRef string,
+) error {
+	config, err := linter.GetConfig(configPath)
+	if err != nil {
+		return err
+	}
+
+	opDirHandle, err := dataResolver.Resolve(
+		ctx,
+		opRef,
+		nil,
+	)
+	if err != nil {
+		return err
+	}
+
+	opFileReader, err := opDirHandle.GetContent(ctx, opfile.FileName)
+	if err != nil {
+		return err
+	}
+	defer opFileReader.Close()
+
+	opFileBytes, err := ioutil.ReadAll(opFileReader)
+	if err != nil {
+		return err
+	}
+
+	problems, err := linter.Lint(opFileBytes, config)
+	if err != nil {
+		return err
+	}
+
+	errCount := 0
+	for _, problem := range problems {
+		if problem.Severity == linter.SeverityError {
+			errCount++
+			cliOutput.Error(problem.String())
+		} else {
+			cliOutput.Warning(problem.String())
+		}
+	}
+
+	if errCount > 0 {
+		return fmt.Errorf("%v lint error(s) found in %v", errCount, opRef)
+	}
+
+	return nil
+}
@@ -0,0 +1,46 @@
+package main
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/opctl/opctl/cli/internal/dataresolver"
+	"github.com/opctl/opctl/sdks/go/data/oci"
+	"github.com/opctl/opctl/sdks/go/model"
+	"github.com/opctl/opctl/sdks/go/opspec"
+)
+
+// opPublish implements "op publish" sub command
+func opPublish(
+	ctx context.Context,
+	dataResolver dataresolver.DataResolver,
+	opRef string,
+	ociRef string,
+	creds *model.Creds,
+) (string, error) {
+	opDirHandle, err := dataResolver.Resolve(
+		ctx,
+		opRef,
+		nil,
+	)
+	if err != nil {
+		return "", err
+	}
+
+	opPath := opDirHandle.Path()
+	if opPath == nil {
+		return "", fmt.Errorf("unable to publish %v: op not available locally", opRef)
+	}
+
+	// never publish invalid ops
+	if err := opspec.Validate(ctx, *opPath); err != nil {
+		return "", err
+	}
+
+	return oci.Push(
+		ctx,
+		*opPath,
+		ociRef,
+		creds,
+	)
+}
@@ -0,0 +1,38 @@
+package main
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/opctl/opctl/cli/internal/dataresolver"
+	"github.com/opctl/opctl/sdks/go/opspec/signature"
+)
+
+// opSign implements "op sign" sub command
+func opSign(
+	ctx context.Context,
+	dataResolver dataresolver.DataResolver,
+	opRef string,
+	keyPath string,
+) (string, error) {
+	privateKey, err := signature.LoadPrivateKey(keyPath)
+	if err != nil {
+		return "", err
+	}
+
+	opDirHandle, err := dataResolver.Resolve(
+		ctx,
+		opRef,
+		nil,
+	)
+	if err != nil {
+		return "", err
+	}
+
+	opPath := opDirHandle.Path()
+	if opPath == nil {
+		return "", fmt.Errorf("unable to sign %v: op not available locally", opRef)
+	}
+
+	return signature.Sign(*opPath, privateKey)
+}
@@ -2,6 +2,8 @@ package main
 
 import (
 	"context"
+	"io/ioutil"
+	"os"
 
 	"github.com/opctl/opctl/cli/internal/dataresolver"
 	"github.com/opctl/opctl/sdks/go/opspec"
@@ -11,16 +13,34 @@ func opValidate(
 	ctx context.Context,
 	dataResolver dataresolver.DataResolver,
 	opRef string,
+	isRecursive bool,
 ) error {
-	opDirHandle, err := dataResolver.Resolve(
+	// ops resolved via the node are installed here so they can be read from the filesystem
+	installPath, err := ioutil.TempDir("", "opctl-ops")
+	if err != nil {
+		return err
+	}
+	defer os.RemoveAll(installPath)
+
+	opDirHandle, err := dataresolver.ResolveLocal(
 		ctx,
+		dataResolver,
 		opRef,
-		nil,
+		installPath,
 	)
 	if err != nil {
 		return err
 	}
 
+	if isRecursive {
+		return opspec.ValidateRecursive(
+			ctx,
+			*opDirHandle.Path(),
+			// child ops are pulled by the node
+			dataresolver.NewProvider(dataResolver, installPath),
+		)
+	}
+
 	return opspec.Validate(
 		ctx,
 		*opDirHandle.Path(),
@@ -6,6 +6,7 @@ import (
 	"io/ioutil"
 	"os"
 	"os/signal"
+	"path/filepath"
 	"syscall"
 	"time"
 
@@ -13,6 +14,7 @@ import (
 	"github.com/opctl/opctl/cli/internal/cliparamsatisfier"
 	"github.com/opctl/opctl/cli/internal/dataresolver"
 	"github.com/opctl/opctl/cli/internal/nodeprovider"
+	"github.com/opctl/opctl/sdks/go/data/git"
 	"github.com/opctl/opctl/sdks/go/model"
 	"github.com/opctl/opctl/sdks/go/opspec/opfile"
 	"github.com/pkg/errors"
@@ -24,13 +26,23 @@ func run(
 	cliOutput clioutput.CliOutput,
 	cliParamSatisfier cliparamsatisfier.CLIParamSatisfier,
 	nodeProvider nodeprovider.NodeProvider,
+	dataDir string,
 	args []string,
 	argFile string,
 	opRef string,
+	refresh bool,
+	isDryRun bool,
 ) error {
 
 	startTime := time.Now().UTC()
 
+	if refresh {
+		// ops are cached in the data dir by the node; expiring cached branches causes it to re-pull them
+		if err := git.ExpireBranches(filepath.Join(dataDir, "ops")); err != nil {
+			return errors.Wrap(err, "unable to refresh cached ops")
+		}
+	}
+
 	node, err := nodeProvider.CreateNodeIfNotExists(ctx)
 	if err != nil {
 		return err
@@ -89,6 +101,17 @@ func run(
 		return err
 	}
 
+	if isDryRun {
+		return runDryRun(
+			ctx,
+			cliOutput,
+			dataResolver,
+			dataDir,
+			opRef,
+			argsMap,
+		)
+	}
+
 	// init signal channels
 	aSigIntWasReceivedAlready := false
 	sigIntChannel := make(chan os.Signal, 1)
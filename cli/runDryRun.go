@@ -0,0 +1,139 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"sort"
+	"strings"
+
+	"github.com/opctl/opctl/cli/internal/clioutput"
+	"github.com/opctl/opctl/cli/internal/dataresolver"
+	"github.com/opctl/opctl/sdks/go/model"
+	"github.com/opctl/opctl/sdks/go/opspec/dryrun"
+)
+
+// runDryRun implements "run --dry-run" command
+func runDryRun(
+	ctx context.Context,
+	cliOutput clioutput.CliOutput,
+	dataResolver dataresolver.DataResolver,
+	dataDir string,
+	opRef string,
+	args map[string]*model.Value,
+) error {
+	// ops resolved via the node are installed here so they can be read from the filesystem
+	installPath, err := ioutil.TempDir("", "opctl-ops")
+	if err != nil {
+		return err
+	}
+	defer os.RemoveAll(installPath)
+
+	opHandle, err := dataresolver.ResolveLocal(
+		ctx,
+		dataResolver,
+		opRef,
+		installPath,
+	)
+	if err != nil {
+		return err
+	}
+
+	calls, err := dryrun.Interpret(
+		ctx,
+		*opHandle.Path(),
+		args,
+		dataDir,
+		// child ops are pulled by the node
+		dataresolver.NewProvider(dataResolver, installPath),
+	)
+	if err != nil {
+		return err
+	}
+
+	isPending := false
+	for _, call := range calls {
+		printDryRunCall(os.Stdout, call)
+		isPending = isPending || len(call.Pending) > 0
+	}
+
+	if isPending {
+		cliOutput.Attention("values marked <pending $(NAME)> aren't known until output by prior calls")
+	}
+
+	return nil
+}
+
+func printDryRunCall(
+	w io.Writer,
+	call *dryrun.Call,
+) {
+	fmt.Fprintln(w, call.Path)
+
+	if call.Err != nil {
+		fmt.Fprintf(w, "  error: %v\n", call.Err)
+	}
+
+	if containerCall := call.Container; containerCall != nil {
+		if containerCall.Image != nil {
+			switch {
+			case containerCall.Image.Ref != nil:
+				fmt.Fprintf(w, "  image: %v\n", *containerCall.Image.Ref)
+			case containerCall.Image.Src != nil && containerCall.Image.Src.Dir != nil:
+				fmt.Fprintf(w, "  image: %v (src)\n", *containerCall.Image.Src.Dir)
+			}
+		}
+
+		if len(containerCall.Cmd) > 0 {
+			quotedCmd := []string{}
+			for _, arg := range containerCall.Cmd {
+				quotedCmd = append(quotedCmd, fmt.Sprintf("%q", arg))
+			}
+			fmt.Fprintf(w, "  cmd: %v\n", strings.Join(quotedCmd, " "))
+		}
+
+		if containerCall.WorkDir != "" {
+			fmt.Fprintf(w, "  workDir: %v\n", containerCall.WorkDir)
+		}
+
+		printDryRunMap(w, "envVars", "=", containerCall.EnvVars)
+		printDryRunMap(w, "dirs", ": ", containerCall.Dirs)
+		printDryRunMap(w, "files", ": ", containerCall.Files)
+		printDryRunMap(w, "sockets", ": ", containerCall.Sockets)
+	}
+
+	if len(call.Pending) > 0 {
+		pendingRefs := []string{}
+		for _, name := range call.Pending {
+			pendingRefs = append(pendingRefs, fmt.Sprintf("$(%v)", name))
+		}
+		fmt.Fprintf(w, "  pending: %v\n", strings.Join(pendingRefs, ", "))
+	}
+
+	fmt.Fprintln(w)
+}
+
+// printDryRunMap prints the entries of m sorted by key
+func printDryRunMap(
+	w io.Writer,
+	name string,
+	separator string,
+	m map[string]string,
+) {
+	if len(m) == 0 {
+		return
+	}
+
+	keys := []string{}
+	for key := range m {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	fmt.Fprintf(w, "  %v:\n", name)
+	for _, key := range keys {
+		fmt.Fprintf(w, "    %v%v%v\n", key, separator, m[key])
+	}
+}
@@ -0,0 +1,301 @@
+// Package cache implements listing & pruning data pulled to the cache by data providers (git, oci & tarball)
+package cache
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+	"time"
+)
+
+// InfoFileExt is appended to the path of cached data to get the path of its Info
+const InfoFileExt = ".json"
+
+// usedAtResolution is how often Info.UsedAt is updated; avoids a write per resolve
+const usedAtResolution = time.Hour
+
+// abandonedPullTTL is how long a pull's temp dir must go unmodified before it's considered abandoned
+const abandonedPullTTL = time.Hour
+
+// pullDirNameRegexp matches names of temp dirs pulls are made to i.e. '.repo#1.0.0.pull123'
+var pullDirNameRegexp = regexp.MustCompile(`^\..+\.pull[0-9]*$`)
+
+// Info describes cached data.
+//
+// Data cached before Info was recorded has none.
+type Info struct {
+	// Commit is the SHA of the commit the data was sourced from; git only
+	Commit string `json:"commit"`
+	// Kind is the kind of git ref the version resolved to; git only
+	Kind     string    `json:"kind"`
+	PulledAt time.Time `json:"pulledAt"`
+	// UsedAt is when the data was last resolved, w/ a resolution of usedAtResolution
+	UsedAt time.Time `json:"usedAt"`
+}
+
+// NewInfo returns Info of data pulled now
+func NewInfo() *Info {
+	now := time.Now().UTC()
+	return &Info{
+		PulledAt: now,
+		UsedAt:   now,
+	}
+}
+
+// MarkUsed records the data cached at path was used
+func (i *Info) MarkUsed(
+	path string,
+) error {
+	if i == nil || time.Since(i.UsedAt) < usedAtResolution {
+		return nil
+	}
+
+	i.UsedAt = time.Now().UTC()
+	return WriteInfo(path, i)
+}
+
+// IsComplete reports whether data cached at path was completely pulled.
+//
+// Pulls are only moved into place once complete, & git clones once their '.git' dir is removed,
+// so a '.git' dir means a clone was interrupted (or predates pulls being moved into place).
+func IsComplete(
+	path string,
+) bool {
+	_, err := os.Stat(filepath.Join(path, ".git"))
+	return os.IsNotExist(err)
+}
+
+// ReadInfo reads the Info of data cached at path; nil if none exists
+func ReadInfo(
+	path string,
+) (*Info, error) {
+	infoBytes, err := ioutil.ReadFile(path + InfoFileExt)
+	if os.IsNotExist(err) {
+		return nil, nil
+	} else if err != nil {
+		return nil, err
+	}
+
+	info := &Info{}
+	if err := json.Unmarshal(infoBytes, info); err != nil {
+		return nil, fmt.Errorf("invalid cache info for '%v': %w", path, err)
+	}
+
+	return info, nil
+}
+
+// WriteInfo writes the Info of data cached at path
+func WriteInfo(
+	path string,
+	info *Info,
+) error {
+	infoBytes, err := json.Marshal(info)
+	if err != nil {
+		return err
+	}
+
+	return ioutil.WriteFile(path+InfoFileExt, infoBytes, 0644)
+}
+
+// Entry is cached data
+type Entry struct {
+	// Ref is the ref of the data relative to the cache i.e. host/path#version
+	Ref  string
+	Path string
+	// Commit is the SHA of the commit the data was sourced from; empty if unknown
+	Commit string
+	// Kind is the kind of git ref the version resolved to; one of: branch, commit, tag or empty if unknown
+	Kind     string
+	PulledAt time.Time
+	UsedAt   time.Time
+	// IsUsageKnown is false if the data was cached before usage was recorded; UsedAt is then when it was pulled
+	IsUsageKnown bool
+	// IsComplete is false if the data was partially pulled; such data is re-pulled when next resolved
+	IsComplete bool
+}
+
+// List lists data cached at basePath ordered by Ref
+func List(
+	basePath string,
+) ([]*Entry, error) {
+	entries := []*Entry{}
+	err := filepath.Walk(
+		basePath,
+		func(path string, fileInfo os.FileInfo, err error) error {
+			if err != nil {
+				return err
+			}
+
+			if !fileInfo.IsDir() {
+				return nil
+			}
+
+			if path != basePath && strings.HasPrefix(fileInfo.Name(), ".") {
+				// in progress (or abandoned) pull; see PruneAbandonedPulls
+				return filepath.SkipDir
+			}
+
+			if !strings.Contains(fileInfo.Name(), "#") {
+				return nil
+			}
+
+			entry, err := newEntry(basePath, path, fileInfo)
+			if err != nil {
+				return err
+			}
+			entries = append(entries, entry)
+
+			return filepath.SkipDir
+		},
+	)
+	if os.IsNotExist(err) {
+		// nothing cached
+		return entries, nil
+	}
+
+	// filepath.Walk walks in lexical order so entries are already ordered
+	return entries, err
+}
+
+// PruneAbandonedPulls removes temp dirs of pulls to basePath which were interrupted i.e. by the
+// process being killed; dirs modified within abandonedPullTTL are assumed in progress.
+//
+// Paths of removed dirs are returned relative to basePath.
+func PruneAbandonedPulls(
+	basePath string,
+) ([]string, error) {
+	removed := []string{}
+	err := filepath.Walk(
+		basePath,
+		func(path string, fileInfo os.FileInfo, err error) error {
+			if err != nil {
+				return err
+			}
+
+			if !fileInfo.IsDir() || path == basePath {
+				return nil
+			}
+
+			if !pullDirNameRegexp.MatchString(fileInfo.Name()) {
+				if strings.HasPrefix(fileInfo.Name(), ".") || strings.Contains(fileInfo.Name(), "#") {
+					// not a pull dir & can't contain one
+					return filepath.SkipDir
+				}
+				return nil
+			}
+
+			modifiedAt, err := lastModified(path)
+			if err != nil {
+				return err
+			}
+
+			if time.Since(modifiedAt) < abandonedPullTTL {
+				// in progress
+				return filepath.SkipDir
+			}
+
+			if err := os.RemoveAll(path); err != nil {
+				return err
+			}
+
+			relPath, err := filepath.Rel(basePath, path)
+			if err != nil {
+				return err
+			}
+			removed = append(removed, filepath.ToSlash(relPath))
+
+			return filepath.SkipDir
+		},
+	)
+	if os.IsNotExist(err) {
+		// nothing cached
+		return removed, nil
+	}
+
+	return removed, err
+}
+
+// lastModified returns when anything within path was last modified
+func lastModified(
+	path string,
+) (time.Time, error) {
+	var modifiedAt time.Time
+	err := filepath.Walk(
+		path,
+		func(path string, fileInfo os.FileInfo, err error) error {
+			if err != nil {
+				return err
+			}
+
+			if fileInfo.ModTime().After(modifiedAt) {
+				modifiedAt = fileInfo.ModTime()
+			}
+			return nil
+		},
+	)
+
+	return modifiedAt, err
+}
+
+func newEntry(
+	basePath string,
+	path string,
+	fileInfo os.FileInfo,
+) (*Entry, error) {
+	relPath, err := filepath.Rel(basePath, path)
+	if err != nil {
+		return nil, err
+	}
+
+	entry := &Entry{
+		Ref:        filepath.ToSlash(relPath),
+		Path:       path,
+		IsComplete: IsComplete(path),
+		// data w/out Info was last modified when pulled
+		PulledAt: fileInfo.ModTime().UTC(),
+		UsedAt:   fileInfo.ModTime().UTC(),
+	}
+
+	info, err := ReadInfo(path)
+	if err != nil {
+		return nil, err
+	}
+
+	if info != nil {
+		entry.Commit = info.Commit
+		entry.Kind = info.Kind
+		entry.PulledAt = info.PulledAt
+		entry.UsedAt = info.UsedAt
+		entry.IsUsageKnown = true
+	}
+
+	return entry, nil
+}
+
+// Remove removes data cached at basePath w/ Entry.Ref ref
+func Remove(
+	basePath string,
+	ref string,
+) error {
+	path := filepath.Join(basePath, filepath.FromSlash(ref))
+	if relPath, err := filepath.Rel(basePath, path); err != nil || relPath == "." || strings.HasPrefix(relPath, "..") {
+		return fmt.Errorf("'%v' isn't a cache ref", ref)
+	}
+
+	if _, err := os.Stat(path); os.IsNotExist(err) {
+		return fmt.Errorf("'%v' not cached", ref)
+	} else if err != nil {
+		return err
+	}
+
+	// remove Info first so data is never left w/ a stale Info
+	if err := os.RemoveAll(path + InfoFileExt); err != nil {
+		return err
+	}
+
+	return os.RemoveAll(path)
+}
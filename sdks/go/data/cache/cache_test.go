@@ -0,0 +1,230 @@
+package cache
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"time"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Context("cache", func() {
+	var basePath string
+	BeforeEach(func() {
+		var err error
+		basePath, err = ioutil.TempDir("", "")
+		if err != nil {
+			panic(err)
+		}
+	})
+
+	Context("Info.MarkUsed", func() {
+		Context("nil", func() {
+			It("should not write Info", func() {
+				/* arrange */
+				var objectUnderTest *Info
+				providedPath := filepath.Join(basePath, "repo#1.0.0")
+
+				/* act */
+				actualErr := objectUnderTest.MarkUsed(providedPath)
+
+				/* assert */
+				Expect(actualErr).To(BeNil())
+				Expect(providedPath + InfoFileExt).NotTo(BeAnExistingFile())
+			})
+		})
+		Context("used within usedAtResolution", func() {
+			It("should not write Info", func() {
+				/* arrange */
+				objectUnderTest := NewInfo()
+				providedPath := filepath.Join(basePath, "repo#1.0.0")
+
+				/* act */
+				actualErr := objectUnderTest.MarkUsed(providedPath)
+
+				/* assert */
+				Expect(actualErr).To(BeNil())
+				Expect(providedPath + InfoFileExt).NotTo(BeAnExistingFile())
+			})
+		})
+		Context("used before usedAtResolution", func() {
+			It("should write Info w/ UsedAt updated", func() {
+				/* arrange */
+				objectUnderTest := &Info{
+					UsedAt: time.Now().Add(-2 * usedAtResolution),
+				}
+				providedPath := filepath.Join(basePath, "repo#1.0.0")
+
+				/* act */
+				actualErr := objectUnderTest.MarkUsed(providedPath)
+
+				/* assert */
+				Expect(actualErr).To(BeNil())
+
+				actualInfo, err := ReadInfo(providedPath)
+				Expect(err).To(BeNil())
+				Expect(actualInfo.UsedAt).To(BeTemporally("~", time.Now(), time.Minute))
+			})
+		})
+	})
+	Context("List", func() {
+		It("should return expected entries", func() {
+			/* arrange */
+			// data w/ Info
+			trackedPath := filepath.Join(basePath, "host.com", "org", "repo#1.0.0")
+			if err := os.MkdirAll(trackedPath, 0777); err != nil {
+				panic(err)
+			}
+
+			expectedInfo := &Info{
+				Commit:   "commit",
+				Kind:     "tag",
+				PulledAt: time.Now().Add(-time.Hour).UTC(),
+				UsedAt:   time.Now().UTC(),
+			}
+			if err := WriteInfo(trackedPath, expectedInfo); err != nil {
+				panic(err)
+			}
+
+			// partial clone
+			partialPath := filepath.Join(basePath, "host.com", "org", "repo#2.0.0")
+			if err := os.MkdirAll(filepath.Join(partialPath, ".git"), 0777); err != nil {
+				panic(err)
+			}
+
+			// in progress pull
+			if err := os.MkdirAll(filepath.Join(basePath, "host.com", "org", ".repo#3.0.0.pull123"), 0777); err != nil {
+				panic(err)
+			}
+
+			/* act */
+			actualEntries, actualErr := List(basePath)
+
+			/* assert */
+			Expect(actualErr).To(BeNil())
+			Expect(actualEntries).To(HaveLen(2))
+
+			Expect(actualEntries[0].Ref).To(Equal("host.com/org/repo#1.0.0"))
+			Expect(actualEntries[0].Path).To(Equal(trackedPath))
+			Expect(actualEntries[0].Commit).To(Equal(expectedInfo.Commit))
+			Expect(actualEntries[0].Kind).To(Equal(expectedInfo.Kind))
+			Expect(actualEntries[0].PulledAt).To(BeTemporally("==", expectedInfo.PulledAt))
+			Expect(actualEntries[0].UsedAt).To(BeTemporally("==", expectedInfo.UsedAt))
+			Expect(actualEntries[0].IsUsageKnown).To(BeTrue())
+			Expect(actualEntries[0].IsComplete).To(BeTrue())
+
+			Expect(actualEntries[1].Ref).To(Equal("host.com/org/repo#2.0.0"))
+			Expect(actualEntries[1].Path).To(Equal(partialPath))
+			Expect(actualEntries[1].Commit).To(BeEmpty())
+			Expect(actualEntries[1].IsUsageKnown).To(BeFalse())
+			Expect(actualEntries[1].IsComplete).To(BeFalse())
+		})
+		Context("basePath doesn't exist", func() {
+			It("should return no entries", func() {
+				/* act */
+				actualEntries, actualErr := List("/doesnt/exist")
+
+				/* assert */
+				Expect(actualErr).To(BeNil())
+				Expect(actualEntries).To(BeEmpty())
+			})
+		})
+	})
+	Context("PruneAbandonedPulls", func() {
+		It("should remove only abandoned pulls", func() {
+			/* arrange */
+			abandonedPath := filepath.Join(basePath, "host.com", "org", ".repo#1.0.0.pull123")
+			if err := os.MkdirAll(filepath.Join(abandonedPath, "repo"), 0777); err != nil {
+				panic(err)
+			}
+
+			abandonedAt := time.Now().Add(-2 * abandonedPullTTL)
+			for _, path := range []string{filepath.Join(abandonedPath, "repo"), abandonedPath} {
+				if err := os.Chtimes(path, abandonedAt, abandonedAt); err != nil {
+					panic(err)
+				}
+			}
+
+			inProgressPath := filepath.Join(basePath, "host.com", "org", ".repo#2.0.0.pull456")
+			if err := os.MkdirAll(inProgressPath, 0777); err != nil {
+				panic(err)
+			}
+
+			cachedPath := filepath.Join(basePath, "host.com", "org", "repo#1.0.0")
+			if err := os.MkdirAll(cachedPath, 0777); err != nil {
+				panic(err)
+			}
+			if err := os.Chtimes(cachedPath, abandonedAt, abandonedAt); err != nil {
+				panic(err)
+			}
+
+			/* act */
+			actualRemoved, actualErr := PruneAbandonedPulls(basePath)
+
+			/* assert */
+			Expect(actualErr).To(BeNil())
+			Expect(actualRemoved).To(ConsistOf("host.com/org/.repo#1.0.0.pull123"))
+
+			Expect(abandonedPath).NotTo(BeADirectory())
+			Expect(inProgressPath).To(BeADirectory())
+			Expect(cachedPath).To(BeADirectory())
+		})
+		Context("basePath doesn't exist", func() {
+			It("should remove nothing", func() {
+				/* act */
+				actualRemoved, actualErr := PruneAbandonedPulls("/doesnt/exist")
+
+				/* assert */
+				Expect(actualErr).To(BeNil())
+				Expect(actualRemoved).To(BeEmpty())
+			})
+		})
+	})
+	Context("Remove", func() {
+		BeforeEach(func() {
+			if err := os.MkdirAll(filepath.Join(basePath, "host.com", "org", "repo#1.0.0", "op"), 0777); err != nil {
+				panic(err)
+			}
+
+			if err := WriteInfo(filepath.Join(basePath, "host.com", "org", "repo#1.0.0"), &Info{}); err != nil {
+				panic(err)
+			}
+		})
+		Context("ref is cached", func() {
+			It("should remove data & Info", func() {
+				/* act */
+				actualErr := Remove(basePath, "host.com/org/repo#1.0.0")
+
+				/* assert */
+				Expect(actualErr).To(BeNil())
+
+				actualEntries, err := List(basePath)
+				Expect(err).To(BeNil())
+				Expect(actualEntries).To(BeEmpty())
+
+				Expect(filepath.Join(basePath, "host.com", "org", "repo#1.0.0.json")).NotTo(BeAnExistingFile())
+			})
+		})
+		Context("ref escapes basePath", func() {
+			It("should return expected error", func() {
+				/* act */
+				actualErr := Remove(filepath.Join(basePath, "host.com"), "../host.com")
+
+				/* assert */
+				Expect(actualErr).To(MatchError("'../host.com' isn't a cache ref"))
+				Expect(filepath.Join(basePath, "host.com")).To(BeADirectory())
+			})
+		})
+		Context("ref isn't cached", func() {
+			It("should return expected error", func() {
+				/* act */
+				actualErr := Remove(basePath, "host.com/org/repo#2.0.0")
+
+				/* assert */
+				Expect(actualErr).To(MatchError("'host.com/org/repo#2.0.0' not cached"))
+			})
+		})
+	})
+})
@@ -0,0 +1,40 @@
+package git
+
+import (
+	"net/http"
+	"sync"
+
+	"github.com/go-git/go-git/v5/plumbing/transport/client"
+	githttp "github.com/go-git/go-git/v5/plumbing/transport/http"
+	"github.com/opctl/opctl/sdks/go/internal/httpclient"
+)
+
+var (
+	installCABundleOnce sync.Once
+	installCABundleErr  error
+)
+
+// installCABundle makes https clones trust certs signed by the CA bundle at httpclient.CABundleEnvVar (in addition to system roots).
+//
+// go-git resolves transports by scheme from a process wide registry so this is done once per process.
+func installCABundle() error {
+	installCABundleOnce.Do(func() {
+		httpClient, err := httpclient.Get()
+		if err != nil {
+			installCABundleErr = err
+			return
+		}
+
+		if httpClient == http.DefaultClient {
+			// no CA bundle; keep go-git's default transport
+			return
+		}
+
+		client.InstallProtocol(
+			"https",
+			githttp.NewClient(httpClient),
+		)
+	})
+
+	return installCABundleErr
+}
@@ -0,0 +1,115 @@
+package git
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/opctl/opctl/sdks/go/data/cache"
+	"github.com/pkg/errors"
+)
+
+// branchTTLEnvVar is the env var overriding how long cached branches are considered fresh
+const branchTTLEnvVar = "OPCTL_GIT_BRANCH_TTL"
+
+// defaultBranchTTL is how long cached branches are considered fresh if branchTTLEnvVar isn't set
+const defaultBranchTTL = 5 * time.Minute
+
+const (
+	refKindBranch = "branch"
+	refKindCommit = "commit"
+	refKindTag    = "tag"
+)
+
+// isStale reports whether data w/ info must be re-pulled; only branches are mutable so only they go stale.
+//
+// Data cached before cache.Info was recorded has none; such data was always sourced from a tag.
+func isStale(
+	info *cache.Info,
+	branchTTL time.Duration,
+) bool {
+	return info != nil && info.Kind == refKindBranch && time.Since(info.PulledAt) >= branchTTL
+}
+
+// commitOf returns the commit data w/ info was sourced from; empty if unknown
+func commitOf(
+	info *cache.Info,
+) string {
+	if info == nil {
+		return ""
+	}
+	return info.Commit
+}
+
+// branchTTL returns how long cached branches are considered fresh
+func branchTTL() (time.Duration, error) {
+	branchTTLString, ok := os.LookupEnv(branchTTLEnvVar)
+	if !ok {
+		return defaultBranchTTL, nil
+	}
+
+	ttl, err := time.ParseDuration(branchTTLString)
+	if err != nil {
+		return 0, fmt.Errorf("invalid %v: %w", branchTTLEnvVar, err)
+	}
+
+	return ttl, nil
+}
+
+// ExpireBranches marks all branches cached at basePath stale so they're re-pulled when next resolved
+func ExpireBranches(
+	basePath string,
+) error {
+	err := filepath.Walk(
+		basePath,
+		func(path string, fileInfo os.FileInfo, err error) error {
+			if err != nil {
+				return err
+			}
+
+			if fileInfo.IsDir() {
+				if strings.Contains(fileInfo.Name(), "#") {
+					// cached data; can't contain cache info
+					return filepath.SkipDir
+				}
+				return nil
+			}
+
+			if !strings.HasSuffix(path, cache.InfoFileExt) {
+				return nil
+			}
+
+			cachedPath := strings.TrimSuffix(path, cache.InfoFileExt)
+			info, err := cache.ReadInfo(cachedPath)
+			if err != nil {
+				return err
+			}
+
+			if info.Kind != refKindBranch {
+				return nil
+			}
+
+			info.PulledAt = time.Time{}
+			return cache.WriteInfo(cachedPath, info)
+		},
+	)
+	if os.IsNotExist(err) {
+		// nothing cached
+		return nil
+	}
+	return err
+}
+
+// ToCacheRef returns the cache.Entry.Ref of data cached for git ref dataRef
+func ToCacheRef(
+	dataRef string,
+) (string, error) {
+	parsedRef, err := parseRef(dataRef)
+	if err != nil {
+		return "", errors.Wrap(err, "invalid git ref")
+	}
+
+	return fmt.Sprintf("%v#%v", parsedRef.Name, parsedRef.Version), nil
+}
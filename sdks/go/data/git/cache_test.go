@@ -0,0 +1,118 @@
+package git
+
+import (
+	"os"
+	"time"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/opctl/opctl/sdks/go/data/cache"
+)
+
+var _ = Context("cache", func() {
+	Context("isStale", func() {
+		Context("nil", func() {
+			It("should return false", func() {
+				/* arrange */
+				var providedInfo *cache.Info
+
+				/* act/assert */
+				Expect(isStale(providedInfo, 0)).To(BeFalse())
+			})
+		})
+		Context("tag pulled before ttl", func() {
+			It("should return false", func() {
+				/* arrange */
+				providedInfo := &cache.Info{Kind: refKindTag}
+
+				/* act/assert */
+				Expect(isStale(providedInfo, time.Minute)).To(BeFalse())
+			})
+		})
+		Context("branch pulled before ttl", func() {
+			It("should return true", func() {
+				/* arrange */
+				providedInfo := &cache.Info{
+					Kind:     refKindBranch,
+					PulledAt: time.Now().Add(-2 * time.Minute),
+				}
+
+				/* act/assert */
+				Expect(isStale(providedInfo, time.Minute)).To(BeTrue())
+			})
+		})
+		Context("branch pulled within ttl", func() {
+			It("should return false", func() {
+				/* arrange */
+				providedInfo := &cache.Info{
+					Kind:     refKindBranch,
+					PulledAt: time.Now(),
+				}
+
+				/* act/assert */
+				Expect(isStale(providedInfo, time.Minute)).To(BeFalse())
+			})
+		})
+	})
+	Context("branchTTL", func() {
+		AfterEach(func() {
+			os.Unsetenv(branchTTLEnvVar)
+		})
+		Context("env var not set", func() {
+			It("should return default", func() {
+				/* act */
+				actualTTL, actualErr := branchTTL()
+
+				/* assert */
+				Expect(actualErr).To(BeNil())
+				Expect(actualTTL).To(Equal(defaultBranchTTL))
+			})
+		})
+		Context("env var set", func() {
+			It("should return expected result", func() {
+				/* arrange */
+				os.Setenv(branchTTLEnvVar, "1h")
+
+				/* act */
+				actualTTL, actualErr := branchTTL()
+
+				/* assert */
+				Expect(actualErr).To(BeNil())
+				Expect(actualTTL).To(Equal(time.Hour))
+			})
+		})
+		Context("env var invalid", func() {
+			It("should return expected error", func() {
+				/* arrange */
+				os.Setenv(branchTTLEnvVar, "notADuration")
+
+				/* act */
+				_, actualErr := branchTTL()
+
+				/* assert */
+				Expect(actualErr).To(MatchError(`invalid OPCTL_GIT_BRANCH_TTL: time: invalid duration "notADuration"`))
+			})
+		})
+	})
+	Context("ToCacheRef", func() {
+		Context("ref isn't a git ref", func() {
+			It("should return expected error", func() {
+				/* act */
+				_, actualErr := ToCacheRef("host.com/org/repo")
+
+				/* assert */
+				Expect(actualErr).To(MatchError("invalid git ref: missing version"))
+			})
+		})
+		Context("ref w/ op path", func() {
+			It("should return expected result", func() {
+				/* act */
+				actualCacheRef, actualErr := ToCacheRef("https://host.com/org/repo#1.0.0/op")
+
+				/* assert */
+				Expect(actualErr).To(BeNil())
+				Expect(actualCacheRef).To(Equal("host.com/org/repo#1.0.0"))
+			})
+		})
+	})
+})
@@ -6,8 +6,10 @@ import (
 	"context"
 	"path/filepath"
 
+	"github.com/opctl/opctl/sdks/go/data/cache"
 	"github.com/opctl/opctl/sdks/go/data/fs"
 	"github.com/opctl/opctl/sdks/go/model"
+	"github.com/pkg/errors"
 	"golang.org/x/sync/singleflight"
 )
 
@@ -41,22 +43,55 @@ func (gp _git) TryResolve(
 	ctx context.Context,
 	dataRef string,
 ) (model.DataHandle, error) {
+	// resolve version ranges first so data is cached (& handles ref'd) by the version resolved
+	dataRef, err := resolveVersionRange(ctx, dataRef, gp.pullCreds)
+	if err != nil {
+		return nil, err
+	}
+
 	// attempt to resolve within singleFlight.Group to ensure concurrent resolves don't race
 	handle, err, _ := resolveSingleFlightGroup.Do(
 		dataRef,
 		func() (interface{}, error) {
+			parsedRef, parseErr := parseRef(dataRef)
+			if parseErr != nil {
+				// refs w/out a version may still be cached as is
+				handle, _ := gp.localFSProvider.TryResolve(ctx, dataRef)
+				if handle != nil {
+					return handle, nil
+				}
+				return nil, errors.Wrap(parseErr, "invalid git ref")
+			}
+
 			// attempt to resolve from cache
-			handle, _ := gp.localFSProvider.TryResolve(ctx, dataRef)
+			handle, _ := gp.localFSProvider.TryResolve(ctx, parsedRef.CacheRef())
 			// ignore errors from local resolution, since we'll try to pull from a remote
 			if handle != nil {
-				return handle, nil
+				repoPath := parsedRef.ToPath(gp.basePath)
+				info, err := cache.ReadInfo(repoPath)
+				if err != nil {
+					return nil, err
+				}
+
+				ttl, err := branchTTL()
+				if err != nil {
+					return nil, err
+				}
+
+				if cache.IsComplete(repoPath) && !isStale(info, ttl) {
+					if err := info.MarkUsed(repoPath); err != nil {
+						return nil, err
+					}
+					return newHandle(*handle.Path(), dataRef, commitOf(info)), nil
+				}
 			}
 
-			// attempt pull if cache miss
-			if err := Pull(ctx, gp.basePath, dataRef, gp.pullCreds); err != nil {
+			// attempt pull if cache miss, stale, or incomplete
+			info, err := pull(ctx, gp.basePath, parsedRef, gp.pullCreds)
+			if err != nil {
 				return nil, err
 			}
-			return newHandle(filepath.Join(gp.basePath, dataRef), dataRef), nil
+			return newHandle(filepath.Join(gp.basePath, parsedRef.CacheRef()), dataRef, commitOf(info)), nil
 		},
 	)
 	if err != nil {
@@ -2,6 +2,7 @@ package git
 
 import (
 	"context"
+	"fmt"
 	"io/ioutil"
 	"os"
 	"path/filepath"
@@ -9,6 +10,7 @@ import (
 
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
+	"github.com/opctl/opctl/sdks/go/data/cache"
 	"github.com/opctl/opctl/sdks/go/model"
 )
 
@@ -84,7 +86,7 @@ var _ = Context("_git", func() {
 							panic(err)
 						}
 						objectUnderTest := New(basePath, nil)
-						expectedHandle := newHandle(filepath.Join(basePath, providedRef), providedRef)
+						expectedHandle := newHandle(filepath.Join(basePath, providedRef), providedRef, "")
 
 						/* act */
 						actualHandle, actualError := objectUnderTest.TryResolve(
@@ -93,8 +95,10 @@ var _ = Context("_git", func() {
 						)
 
 						/* assert */
-						Expect(actualHandle).To(Equal(expectedHandle))
 						Expect(actualError).To(BeNil())
+						Expect(actualHandle.Path()).To(Equal(expectedHandle.Path()))
+						Expect(actualHandle.Ref()).To(Equal(expectedHandle.Ref()))
+						Expect(actualHandle.(handle).Commit()).To(MatchRegexp("^[0-9a-f]{40}$"))
 					})
 				})
 			})
@@ -112,7 +116,7 @@ var _ = Context("_git", func() {
 
 				objectUnderTest := New(basePath, nil)
 
-				expectedResult := newHandle(filepath.Join(basePath, providedRef), providedRef)
+				expectedResult := newHandle(filepath.Join(basePath, providedRef), providedRef, "")
 
 				var (
 					actualResult1,
@@ -166,8 +170,8 @@ var _ = Context("_git", func() {
 
 				objectUnderTest := New(basePath, nil)
 
-				expectedResult1 := newHandle(filepath.Join(basePath, providedRef1), providedRef1)
-				expectedResult2 := newHandle(filepath.Join(basePath, providedRef2), providedRef2)
+				expectedResult1 := newHandle(filepath.Join(basePath, providedRef1), providedRef1, "")
+				expectedResult2 := newHandle(filepath.Join(basePath, providedRef2), providedRef2, "")
 
 				var (
 					actualResult1,
@@ -208,5 +212,170 @@ var _ = Context("_git", func() {
 				Expect(actualResult2.Path()).To(Equal(expectedResult2.Path()))
 			})
 		})
+		Context("cached branch", func() {
+			Context("fresh", func() {
+				It("should return cached commit", func() {
+					/* arrange */
+					repoPath, firstCommitHash := newTestRepo("name: first")
+					providedRef := fmt.Sprintf("file://%v#master", filepath.ToSlash(repoPath))
+
+					basePath, err := ioutil.TempDir("", "")
+					if err != nil {
+						panic(err)
+					}
+
+					objectUnderTest := New(basePath, nil)
+
+					if _, err := objectUnderTest.TryResolve(context.Background(), providedRef); err != nil {
+						panic(err)
+					}
+
+					commitTestRepo(repoPath, "name: second")
+
+					/* act */
+					actualHandle, actualErr := objectUnderTest.TryResolve(
+						context.Background(),
+						providedRef,
+					)
+
+					/* assert */
+					Expect(actualErr).To(BeNil())
+					Expect(actualHandle.(handle).Commit()).To(Equal(firstCommitHash.String()))
+				})
+			})
+			Context("expired", func() {
+				It("should re-pull branch", func() {
+					/* arrange */
+					repoPath, _ := newTestRepo("name: first")
+					providedRef := fmt.Sprintf("file://%v#master", filepath.ToSlash(repoPath))
+
+					basePath, err := ioutil.TempDir("", "")
+					if err != nil {
+						panic(err)
+					}
+
+					objectUnderTest := New(basePath, nil)
+
+					if _, err := objectUnderTest.TryResolve(context.Background(), providedRef); err != nil {
+						panic(err)
+					}
+
+					secondCommitHash := commitTestRepo(repoPath, "name: second")
+
+					if err := ExpireBranches(basePath); err != nil {
+						panic(err)
+					}
+
+					/* act */
+					actualHandle, actualErr := objectUnderTest.TryResolve(
+						context.Background(),
+						providedRef,
+					)
+
+					/* assert */
+					Expect(actualErr).To(BeNil())
+					Expect(actualHandle.(handle).Commit()).To(Equal(secondCommitHash.String()))
+
+					actualOpFileBytes, err := ioutil.ReadFile(filepath.Join(*actualHandle.Path(), "op.yml"))
+					Expect(err).To(BeNil())
+					Expect(string(actualOpFileBytes)).To(Equal("name: second"))
+				})
+			})
+		})
+		Context("cached data incomplete", func() {
+			It("should re-pull", func() {
+				/* arrange */
+				repoPath, _ := newTestRepo("name: test")
+				providedRef := fmt.Sprintf("file://%v#1.0.0", filepath.ToSlash(repoPath))
+
+				basePath, err := ioutil.TempDir("", "")
+				if err != nil {
+					panic(err)
+				}
+
+				parsedRef, err := parseRef(providedRef)
+				if err != nil {
+					panic(err)
+				}
+
+				// partial clone
+				if err := os.MkdirAll(filepath.Join(parsedRef.ToPath(basePath), ".git"), 0777); err != nil {
+					panic(err)
+				}
+
+				objectUnderTest := New(basePath, nil)
+
+				/* act */
+				actualHandle, actualErr := objectUnderTest.TryResolve(
+					context.Background(),
+					providedRef,
+				)
+
+				/* assert */
+				Expect(actualErr).To(BeNil())
+				Expect(cache.IsComplete(*actualHandle.Path())).To(BeTrue())
+
+				actualOpFileBytes, err := ioutil.ReadFile(filepath.Join(*actualHandle.Path(), "op.yml"))
+				Expect(err).To(BeNil())
+				Expect(string(actualOpFileBytes)).To(Equal("name: test"))
+			})
+		})
+		Context("version range", func() {
+			It("should return handle w/ ref to version resolved", func() {
+				/* arrange */
+				repoPath, commitHash := newTestRepo("name: test")
+				providedRef := fmt.Sprintf("file://%v#^1", filepath.ToSlash(repoPath))
+
+				basePath, err := ioutil.TempDir("", "")
+				if err != nil {
+					panic(err)
+				}
+
+				objectUnderTest := New(basePath, nil)
+
+				/* act */
+				actualHandle, actualErr := objectUnderTest.TryResolve(
+					context.Background(),
+					providedRef,
+				)
+
+				/* assert */
+				Expect(actualErr).To(BeNil())
+				Expect(actualHandle.Ref()).To(Equal(fmt.Sprintf("file://%v#1.0.0", filepath.ToSlash(repoPath))))
+				Expect(actualHandle.(handle).Commit()).To(Equal(commitHash.String()))
+			})
+		})
+		Context("cached tag & ExpireBranches called", func() {
+			It("should return cached commit", func() {
+				/* arrange */
+				repoPath, firstCommitHash := newTestRepo("name: first")
+				providedRef := fmt.Sprintf("file://%v#1.0.0", filepath.ToSlash(repoPath))
+
+				basePath, err := ioutil.TempDir("", "")
+				if err != nil {
+					panic(err)
+				}
+
+				objectUnderTest := New(basePath, nil)
+
+				if _, err := objectUnderTest.TryResolve(context.Background(), providedRef); err != nil {
+					panic(err)
+				}
+
+				if err := ExpireBranches(basePath); err != nil {
+					panic(err)
+				}
+
+				/* act */
+				actualHandle, actualErr := objectUnderTest.TryResolve(
+					context.Background(),
+					providedRef,
+				)
+
+				/* assert */
+				Expect(actualErr).To(BeNil())
+				Expect(actualHandle.(handle).Commit()).To(Equal(firstCommitHash.String()))
+			})
+		})
 	})
 })
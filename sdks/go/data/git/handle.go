@@ -12,8 +12,10 @@ import (
 func newHandle(
 	path string,
 	dataRef string,
+	commit string,
 ) model.DataHandle {
 	return handle{
+		commit:  commit,
 		path:    path,
 		dataRef: dataRef,
 	}
@@ -21,10 +23,16 @@ func newHandle(
 
 // handle allows interacting w/ data sourced from git
 type handle struct {
+	commit  string
 	path    string
 	dataRef string
 }
 
+// Commit returns the SHA of the commit the data was sourced from; empty if unknown
+func (gh handle) Commit() string {
+	return gh.commit
+}
+
 func (gh handle) GetContent(
 	ctx context.Context,
 	contentPath string,
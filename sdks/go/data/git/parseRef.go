@@ -2,29 +2,109 @@ package git
 
 import (
 	"errors"
+	"fmt"
 	"net/url"
 	"path"
 	"path/filepath"
+	"regexp"
 	"strings"
 )
 
+// scpLikeRefRegexp matches scp-like refs i.e. [user@]host:path; single char hosts are excluded
+// so windows paths (c:/...) aren't mistaken for them
+var scpLikeRefRegexp = regexp.MustCompile(`^(?:([^@/:]+)@)?([^@/:]{2,}):([^/].*)$`)
+
 // parseRef string to object
+//
+// refs MAY be:
+//  - host/path#fragment; cloned via https
+//  - scheme://[user@]host/path#fragment w/ scheme one of file, http, https, ssh
+//  - [user@]host:path#fragment (scp-like); cloned via ssh
 func parseRef(
 	dataRef string,
 ) (*ref, error) {
-	refURI, err := url.Parse(filepath.ToSlash(dataRef))
+	dataRef = filepath.ToSlash(dataRef)
+
+	if scpLikeRefMatch := scpLikeRefRegexp.FindStringSubmatch(dataRef); scpLikeRefMatch != nil && !strings.Contains(dataRef, "://") {
+		user, host := scpLikeRefMatch[1], scpLikeRefMatch[2]
+		repoPath, fragment := scpLikeRefMatch[3], ""
+		if fragmentIndex := strings.Index(repoPath, "#"); fragmentIndex >= 0 {
+			repoPath, fragment = repoPath[:fragmentIndex], repoPath[fragmentIndex+1:]
+		}
+
+		cloneURL := fmt.Sprintf("%v:%v", host, repoPath)
+		if user != "" {
+			cloneURL = fmt.Sprintf("%v@%v", user, cloneURL)
+		}
+
+		return newRef(
+			path.Join(host, repoPath),
+			fragment,
+			cloneURL,
+			"ssh",
+		)
+	}
+
+	refURI, err := url.Parse(dataRef)
 	if err != nil {
 		return nil, err
 	}
 
-	// fragment MAY be in format: SEM_VER/OP_PATH
-	version := strings.SplitN(refURI.Fragment, "/", 2)[0]
+	fragment := refURI.Fragment
+	refURI.Fragment = ""
+
+	switch refURI.Scheme {
+	case "":
+		name := path.Join(refURI.Host, refURI.Path)
+		return newRef(
+			name,
+			fragment,
+			fmt.Sprintf("https://%v", name),
+			"https",
+		)
+	case "file":
+		return newRef(
+			// prefixed so file refs can't collide w/ remote refs
+			path.Join("file", refURI.Host, refURI.Path),
+			fragment,
+			refURI.String(),
+			refURI.Scheme,
+		)
+	case "http", "https", "ssh":
+		return newRef(
+			path.Join(refURI.Host, refURI.Path),
+			fragment,
+			refURI.String(),
+			refURI.Scheme,
+		)
+	default:
+		return nil, fmt.Errorf("unsupported scheme '%v'", refURI.Scheme)
+	}
+}
+
+// newRef constructs a ref from its parts; fragment MAY be in format: VERSION/OP_PATH
+func newRef(
+	name string,
+	fragment string,
+	cloneURL string,
+	scheme string,
+) (*ref, error) {
+	fragmentParts := strings.SplitN(fragment, "/", 2)
+	version := fragmentParts[0]
 	if version == "" {
 		return nil, errors.New("missing version")
 	}
 
+	var opPath string
+	if len(fragmentParts) == 2 {
+		opPath = fragmentParts[1]
+	}
+
 	return &ref{
-		Name:    path.Join(refURI.Host, refURI.Path),
-		Version: version,
+		CloneURL: cloneURL,
+		Name:     name,
+		OpPath:   opPath,
+		Scheme:   scheme,
+		Version:  version,
 	}, nil
 }
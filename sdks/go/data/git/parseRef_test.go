@@ -30,6 +30,22 @@ var _ = Context("refParser", func() {
 				Expect(actualPath).To(Equal(expectedPath))
 			})
 		})
+		Context("CacheRef", func() {
+			It("should return expected ref", func() {
+				/* arrange */
+				objectUnderTest := &ref{
+					Name:    "test.com/org/pkg-name",
+					OpPath:  "op/path",
+					Version: "0.0.0",
+				}
+
+				/* act */
+				actualCacheRef := objectUnderTest.CacheRef()
+
+				/* assert */
+				Expect(actualCacheRef).To(Equal("test.com/org/pkg-name#0.0.0/op/path"))
+			})
+		})
 	})
 	Context("Parse", func() {
 		Context("url.Parse errors", func() {
@@ -51,8 +67,11 @@ var _ = Context("refParser", func() {
 				providedPkgVersion := "0.0.0"
 				providedDataRef := fmt.Sprintf("%v#%v/some/op/path", providedFullyQualifiedPkgName, providedPkgVersion)
 				expectedDataRef := &ref{
-					Name:    providedFullyQualifiedPkgName,
-					Version: providedPkgVersion,
+					CloneURL: fmt.Sprintf("https://%v", providedFullyQualifiedPkgName),
+					Name:     providedFullyQualifiedPkgName,
+					OpPath:   "some/op/path",
+					Scheme:   "https",
+					Version:  providedPkgVersion,
 				}
 
 				/* act */
@@ -64,5 +83,60 @@ var _ = Context("refParser", func() {
 
 			})
 		})
+		Context("ssh scheme", func() {
+			It("should return expected Ref", func() {
+				/* arrange/act */
+				actualDataRef, actualErr := parseRef("ssh://git@somehost.com:2222/path/repo.git#1.0.0")
+
+				/* assert */
+				Expect(actualErr).To(BeNil())
+				Expect(actualDataRef).To(Equal(&ref{
+					CloneURL: "ssh://git@somehost.com:2222/path/repo.git",
+					Name:     "somehost.com:2222/path/repo.git",
+					Scheme:   "ssh",
+					Version:  "1.0.0",
+				}))
+			})
+		})
+		Context("scp-like", func() {
+			It("should return expected Ref", func() {
+				/* arrange/act */
+				actualDataRef, actualErr := parseRef("git@somehost.com:path/repo.git#1.0.0/some/op/path")
+
+				/* assert */
+				Expect(actualErr).To(BeNil())
+				Expect(actualDataRef).To(Equal(&ref{
+					CloneURL: "git@somehost.com:path/repo.git",
+					Name:     "somehost.com/path/repo.git",
+					OpPath:   "some/op/path",
+					Scheme:   "ssh",
+					Version:  "1.0.0",
+				}))
+			})
+		})
+		Context("file scheme", func() {
+			It("should return expected Ref", func() {
+				/* arrange/act */
+				actualDataRef, actualErr := parseRef("file:///some/repo#1.0.0")
+
+				/* assert */
+				Expect(actualErr).To(BeNil())
+				Expect(actualDataRef).To(Equal(&ref{
+					CloneURL: "file:///some/repo",
+					Name:     "file/some/repo",
+					Scheme:   "file",
+					Version:  "1.0.0",
+				}))
+			})
+		})
+		Context("unsupported scheme", func() {
+			It("should error", func() {
+				/* arrange/act */
+				_, actualErr := parseRef("ftp://somehost.com/repo#1.0.0")
+
+				/* assert */
+				Expect(actualErr).To(MatchError("unsupported scheme 'ftp'"))
+			})
+		})
 	})
 })
@@ -3,20 +3,29 @@ package git
 import (
 	"context"
 	"fmt"
+	"io/ioutil"
 	"os"
 	"path/filepath"
+	"regexp"
 
 	"github.com/go-git/go-git/v5"
 	"github.com/go-git/go-git/v5/plumbing"
 	"github.com/go-git/go-git/v5/plumbing/transport"
 	"github.com/go-git/go-git/v5/plumbing/transport/http"
+	"github.com/opctl/opctl/sdks/go/data/cache"
 	"github.com/opctl/opctl/sdks/go/model"
 	"github.com/pkg/errors"
 )
 
+// commitSHARegexp matches full commit SHAs; abbreviated SHAs are ambiguous so aren't supported
+var commitSHARegexp = regexp.MustCompile(`^[0-9a-f]{40}$`)
+
 // Pull pulls 'dataRef' to 'path'
 // nil pullCreds will be ignored
 //
+// The version of 'dataRef' MAY be a full commit SHA, a tag, or a branch; tags take precedence over branches.
+// Any data previously pulled for 'dataRef' is replaced.
+//
 // expected errs:
 //  - ErrDataProviderAuthentication on authentication failure
 //  - ErrDataProviderAuthorization on authorization failure
@@ -32,45 +41,171 @@ func Pull(
 		return errors.Wrap(err, "invalid git ref")
 	}
 
+	_, err = pull(ctx, path, parsedPkgRef, authOpts)
+	return err
+}
+
+// pull pulls 'parsedPkgRef' to 'path' & records its cache.Info
+func pull(
+	ctx context.Context,
+	path string,
+	parsedPkgRef *ref,
+	authOpts *model.Creds,
+) (*cache.Info, error) {
+	cloneOptions, err := newCloneOptions(parsedPkgRef, authOpts)
+	if err != nil {
+		return nil, err
+	}
+
 	opPath := parsedPkgRef.ToPath(path)
 
+	if err := os.MkdirAll(filepath.Dir(opPath), 0777); err != nil {
+		return nil, err
+	}
+
+	// clone beside opPath then swap it in so existing data remains intact (& in use) until the clone succeeds
+	tmpPath, err := ioutil.TempDir(filepath.Dir(opPath), fmt.Sprintf(".%v.pull", filepath.Base(opPath)))
+	if err != nil {
+		return nil, err
+	}
+	defer os.RemoveAll(tmpPath)
+
+	clonePath := filepath.Join(tmpPath, "repo")
+	info, err := clone(ctx, clonePath, parsedPkgRef, cloneOptions)
+	if err != nil {
+		return nil, err
+	}
+
+	// remove pkg '.git' sub dir
+	if err := os.RemoveAll(filepath.Join(clonePath, ".git")); err != nil {
+		return nil, err
+	}
+
+	if err := os.RemoveAll(opPath); err != nil {
+		return nil, err
+	}
+
+	if err := os.Rename(clonePath, opPath); err != nil {
+		return nil, err
+	}
+
+	return info, cache.WriteInfo(opPath, info)
+}
+
+// newCloneOptions returns options for cloning 'parsedPkgRef', authenticating w/ 'authOpts' if not nil
+func newCloneOptions(
+	parsedPkgRef *ref,
+	authOpts *model.Creds,
+) (*git.CloneOptions, error) {
 	cloneOptions := &git.CloneOptions{
-		URL:           fmt.Sprintf("https://%v", parsedPkgRef.Name),
-		ReferenceName: plumbing.ReferenceName(fmt.Sprintf("refs/tags/%v", parsedPkgRef.Version)),
-		Depth:         1,
-		Progress:      os.Stdout,
+		URL:      parsedPkgRef.CloneURL,
+		Progress: os.Stdout,
 	}
 
-	if authOpts != nil {
-		cloneOptions.Auth = &http.BasicAuth{
-			Username: authOpts.Username,
-			Password: authOpts.Password,
+	var err error
+	switch parsedPkgRef.Scheme {
+	case "http", "https":
+		if err := installCABundle(); err != nil {
+			return nil, err
 		}
-	}
 
-	if _, err := git.PlainClone(
-		opPath,
-		false,
-		cloneOptions,
-	); err != nil {
-		if _, ok := err.(git.NoMatchingRefSpecError); ok {
-			return fmt.Errorf("version \"%s\" not found", parsedPkgRef.Version)
+		if authOpts != nil {
+			cloneOptions.Auth = &http.BasicAuth{
+				Username: authOpts.Username,
+				Password: authOpts.Password,
+			}
 		}
-		if errors.Is(err, transport.ErrAuthenticationRequired) {
-			return model.ErrDataProviderAuthentication{}
+	case "ssh":
+		cloneOptions.Auth, err = newSSHAuth(parsedPkgRef.CloneURL, authOpts)
+		if err != nil {
+			return nil, err
 		}
-		if errors.Is(err, transport.ErrAuthorizationFailed) {
-			return model.ErrDataProviderAuthorization{}
+	}
+
+	return cloneOptions, nil
+}
+
+// clone clones 'parsedPkgRef' to 'path'
+func clone(
+	ctx context.Context,
+	path string,
+	parsedPkgRef *ref,
+	cloneOptions *git.CloneOptions,
+) (*cache.Info, error) {
+	var (
+		err  error
+		kind string
+		repo *git.Repository
+	)
+	if commitSHARegexp.MatchString(parsedPkgRef.Version) {
+		// servers generally don't allow fetching unadvertised commits so fetch all & checkout the commit
+		kind = refKindCommit
+		cloneOptions.NoCheckout = true
+
+		repo, err = git.PlainCloneContext(ctx, path, false, cloneOptions)
+		if err == nil {
+			err = checkoutCommit(repo, parsedPkgRef.Version)
 		}
-		if errors.Is(err, git.ErrRepositoryAlreadyExists) {
-			// if the repository already exists, it's already been cloned and we can
-			// procede. Maybe a concurrent puller got it?
-			return nil
+	} else {
+		kind = refKindTag
+		cloneOptions.Depth = 1
+		cloneOptions.SingleBranch = true
+		cloneOptions.ReferenceName = plumbing.NewTagReferenceName(parsedPkgRef.Version)
+
+		repo, err = git.PlainCloneContext(ctx, path, false, cloneOptions)
+		if _, ok := err.(git.NoMatchingRefSpecError); ok {
+			// fallback to branch
+			if err := os.RemoveAll(path); err != nil {
+				return nil, err
+			}
+
+			kind = refKindBranch
+			cloneOptions.ReferenceName = plumbing.NewBranchReferenceName(parsedPkgRef.Version)
+
+			repo, err = git.PlainCloneContext(ctx, path, false, cloneOptions)
+		}
+	}
+	if err != nil {
+		if _, ok := err.(git.NoMatchingRefSpecError); ok || errors.Is(err, plumbing.ErrObjectNotFound) {
+			return nil, fmt.Errorf("version \"%s\" not found", parsedPkgRef.Version)
 		}
+		return nil, toDataProviderErr(err)
+	}
+
+	head, err := repo.Head()
+	if err != nil {
+		return nil, err
+	}
+
+	info := cache.NewInfo()
+	info.Commit = head.Hash().String()
+	info.Kind = kind
+	return info, nil
+}
+
+func checkoutCommit(
+	repo *git.Repository,
+	commitSHA string,
+) error {
+	workTree, err := repo.Worktree()
+	if err != nil {
 		return err
 	}
 
-	// remove pkg '.git' sub dir
-	return os.RemoveAll(filepath.Join(opPath, ".git"))
+	return workTree.Checkout(&git.CheckoutOptions{
+		Hash: plumbing.NewHash(commitSHA),
+	})
+}
 
+// toDataProviderErr maps auth errors of git transports to their model.DataProvider equivalent
+func toDataProviderErr(
+	err error,
+) error {
+	if errors.Is(err, transport.ErrAuthenticationRequired) || isSSHAuthenticationErr(err) {
+		return model.ErrDataProviderAuthentication{}
+	}
+	if errors.Is(err, transport.ErrAuthorizationFailed) {
+		return model.ErrDataProviderAuthorization{}
+	}
+	return err
 }
@@ -7,9 +7,16 @@ import (
 	"io/ioutil"
 	"net/http"
 	"net/http/httptest"
+	"path/filepath"
+	"time"
+
+	"github.com/go-git/go-git/v5"
+	"github.com/go-git/go-git/v5/plumbing"
+	"github.com/go-git/go-git/v5/plumbing/object"
 
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
+	"github.com/opctl/opctl/sdks/go/data/cache"
 	"github.com/opctl/opctl/sdks/go/model"
 )
 
@@ -174,5 +181,207 @@ var _ = Context("Pull", func() {
 				})
 			})
 		})
+		Context("file scheme", func() {
+			It("should pull expected version", func() {
+				/* arrange */
+				repoPath, commitHash := newTestRepo("name: test")
+				providedRef := fmt.Sprintf("file://%v#1.0.0", filepath.ToSlash(repoPath))
+
+				providedPath, err := ioutil.TempDir("", "")
+				if err != nil {
+					panic(err)
+				}
+
+				/* act */
+				actualError := Pull(
+					context.Background(),
+					providedPath,
+					providedRef,
+					nil,
+				)
+
+				/* assert */
+				Expect(actualError).To(BeNil())
+
+				parsedRef, err := parseRef(providedRef)
+				if err != nil {
+					panic(err)
+				}
+
+				actualOpFileBytes, err := ioutil.ReadFile(filepath.Join(parsedRef.ToPath(providedPath), "op.yml"))
+				Expect(err).To(BeNil())
+				Expect(string(actualOpFileBytes)).To(Equal("name: test"))
+
+				actualCacheInfo, err := cache.ReadInfo(parsedRef.ToPath(providedPath))
+				Expect(err).To(BeNil())
+				Expect(actualCacheInfo.Commit).To(Equal(commitHash.String()))
+				Expect(actualCacheInfo.Kind).To(Equal(refKindTag))
+			})
+			Context("version is a branch", func() {
+				It("should pull expected branch", func() {
+					/* arrange */
+					repoPath, commitHash := newTestRepo("name: test")
+					providedRef := fmt.Sprintf("file://%v#master", filepath.ToSlash(repoPath))
+
+					providedPath, err := ioutil.TempDir("", "")
+					if err != nil {
+						panic(err)
+					}
+
+					/* act */
+					actualError := Pull(
+						context.Background(),
+						providedPath,
+						providedRef,
+						nil,
+					)
+
+					/* assert */
+					Expect(actualError).To(BeNil())
+
+					parsedRef, err := parseRef(providedRef)
+					if err != nil {
+						panic(err)
+					}
+
+					actualCacheInfo, err := cache.ReadInfo(parsedRef.ToPath(providedPath))
+					Expect(err).To(BeNil())
+					Expect(actualCacheInfo.Commit).To(Equal(commitHash.String()))
+					Expect(actualCacheInfo.Kind).To(Equal(refKindBranch))
+				})
+			})
+			Context("version is a commit SHA", func() {
+				It("should pull expected commit", func() {
+					/* arrange */
+					repoPath, commitHash := newTestRepo("name: test")
+					providedRef := fmt.Sprintf("file://%v#%v", filepath.ToSlash(repoPath), commitHash)
+
+					providedPath, err := ioutil.TempDir("", "")
+					if err != nil {
+						panic(err)
+					}
+
+					/* act */
+					actualError := Pull(
+						context.Background(),
+						providedPath,
+						providedRef,
+						nil,
+					)
+
+					/* assert */
+					Expect(actualError).To(BeNil())
+
+					parsedRef, err := parseRef(providedRef)
+					if err != nil {
+						panic(err)
+					}
+
+					actualOpFileBytes, err := ioutil.ReadFile(filepath.Join(parsedRef.ToPath(providedPath), "op.yml"))
+					Expect(err).To(BeNil())
+					Expect(string(actualOpFileBytes)).To(Equal("name: test"))
+
+					actualCacheInfo, err := cache.ReadInfo(parsedRef.ToPath(providedPath))
+					Expect(err).To(BeNil())
+					Expect(actualCacheInfo.Commit).To(Equal(commitHash.String()))
+					Expect(actualCacheInfo.Kind).To(Equal(refKindCommit))
+				})
+			})
+			Context("version doesn't exist", func() {
+				It("should return expected error", func() {
+					/* arrange */
+					repoPath, _ := newTestRepo("name: test")
+
+					providedPath, err := ioutil.TempDir("", "")
+					if err != nil {
+						panic(err)
+					}
+
+					/* act */
+					actualError := Pull(
+						context.Background(),
+						providedPath,
+						fmt.Sprintf("file://%v#2.0.0", filepath.ToSlash(repoPath)),
+						nil,
+					)
+
+					/* assert */
+					Expect(actualError).To(MatchError(`version "2.0.0" not found`))
+				})
+			})
+		})
+		Context("ssh scheme & pullCreds password isn't a private key", func() {
+			It("should return expected error", func() {
+				/* arrange/act */
+				actualError := Pull(
+					context.Background(),
+					"dummyPath",
+					"git@somehost.com:org/repo#1.0.0",
+					&model.Creds{
+						Username: "git",
+						Password: "notAPrivateKey",
+					},
+				)
+
+				/* assert */
+				Expect(actualError.Error()).To(HavePrefix("invalid ssh pull creds: password must be a PEM encoded private key"))
+			})
+		})
 	})
 })
+
+// newTestRepo creates a git repo w/ an op.yml containing opFileContent committed to master & tagged 1.0.0
+func newTestRepo(
+	opFileContent string,
+) (string, plumbing.Hash) {
+	repoPath, err := ioutil.TempDir("", "")
+	if err != nil {
+		panic(err)
+	}
+
+	repo, err := git.PlainInit(repoPath, false)
+	if err != nil {
+		panic(err)
+	}
+
+	commitHash := commitTestRepo(repoPath, opFileContent)
+
+	if _, err := repo.CreateTag("1.0.0", commitHash, nil); err != nil {
+		panic(err)
+	}
+
+	return repoPath, commitHash
+}
+
+// commitTestRepo commits an op.yml containing opFileContent to the git repo at repoPath
+func commitTestRepo(
+	repoPath string,
+	opFileContent string,
+) plumbing.Hash {
+	repo, err := git.PlainOpen(repoPath)
+	if err != nil {
+		panic(err)
+	}
+
+	if err := ioutil.WriteFile(filepath.Join(repoPath, "op.yml"), []byte(opFileContent), 0644); err != nil {
+		panic(err)
+	}
+
+	workTree, err := repo.Worktree()
+	if err != nil {
+		panic(err)
+	}
+
+	if _, err := workTree.Add("op.yml"); err != nil {
+		panic(err)
+	}
+
+	commitHash, err := workTree.Commit("update op.yml", &git.CommitOptions{
+		Author: &object.Signature{Name: "test", Email: "test@test.com", When: time.Now()},
+	})
+	if err != nil {
+		panic(err)
+	}
+
+	return commitHash
+}
@@ -2,13 +2,19 @@ package git
 
 import (
 	"fmt"
+	"path"
 	"path/filepath"
 )
 
 type ref struct {
+	// CloneURL is the URL the repo is cloned from
+	CloneURL string
+	// Name is the repo name; unique across schemes except http(s) & ssh refs to the same repo share it
 	Name    string
 	Version string
 	OpPath  string
+	// Scheme is the scheme of CloneURL; ssh for scp-like refs
+	Scheme string
 }
 
 // ToPath constructs a filesystem path for a Ref, assuming the provided base path
@@ -16,3 +22,8 @@ func (pr ref) ToPath(basePath string) string {
 	crossPlatPath := filepath.FromSlash(fmt.Sprintf("%v#%v", pr.Name, pr.Version))
 	return filepath.Join(basePath, crossPlatPath)
 }
+
+// CacheRef returns the ref, relative to a base path, data of the Ref is cached at
+func (pr ref) CacheRef() string {
+	return path.Join(fmt.Sprintf("%v#%v", pr.Name, pr.Version), pr.OpPath)
+}
@@ -0,0 +1,74 @@
+package git
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/go-git/go-git/v5/plumbing/transport"
+	gitssh "github.com/go-git/go-git/v5/plumbing/transport/ssh"
+	"github.com/opctl/opctl/sdks/go/model"
+	"github.com/pkg/errors"
+)
+
+// defaultSSHKeyFileNames are tried, in order, from ~/.ssh when neither pullCreds nor an ssh-agent are available
+var defaultSSHKeyFileNames = []string{"id_ed25519", "id_ecdsa", "id_rsa"}
+
+// newSSHAuth returns auth for cloning cloneURL via ssh.
+//
+// Auth is sourced from the first available of:
+//  - pullCreds; Username is the ssh user & Password a PEM encoded private key
+//  - an ssh-agent listening on SSH_AUTH_SOCK
+//  - an unencrypted default key file (~/.ssh/id_ed25519, ~/.ssh/id_ecdsa, or ~/.ssh/id_rsa)
+//
+// Host keys are verified against known_hosts (SSH_KNOWN_HOSTS or ~/.ssh/known_hosts).
+func newSSHAuth(
+	cloneURL string,
+	pullCreds *model.Creds,
+) (transport.AuthMethod, error) {
+	endpoint, err := transport.NewEndpoint(cloneURL)
+	if err != nil {
+		return nil, err
+	}
+
+	user := endpoint.User
+	if pullCreds != nil && pullCreds.Username != "" {
+		user = pullCreds.Username
+	}
+	if user == "" {
+		user = gitssh.DefaultUsername
+	}
+
+	if pullCreds != nil && pullCreds.Password != "" {
+		auth, err := gitssh.NewPublicKeys(user, []byte(pullCreds.Password), "")
+		if err != nil {
+			return nil, errors.Wrap(err, "invalid ssh pull creds: password must be a PEM encoded private key")
+		}
+		return auth, nil
+	}
+
+	if _, ok := os.LookupEnv("SSH_AUTH_SOCK"); ok {
+		return gitssh.NewSSHAgentAuth(user)
+	}
+
+	homeDir, err := os.UserHomeDir()
+	if err != nil {
+		return nil, err
+	}
+
+	for _, keyFileName := range defaultSSHKeyFileNames {
+		keyFilePath := filepath.Join(homeDir, ".ssh", keyFileName)
+		if _, err := os.Stat(keyFilePath); err == nil {
+			return gitssh.NewPublicKeysFromFile(user, keyFilePath, "")
+		}
+	}
+
+	return nil, model.ErrDataProviderAuthentication{}
+}
+
+// isSSHAuthenticationErr returns whether err is due to the ssh server rejecting auth
+func isSSHAuthenticationErr(
+	err error,
+) bool {
+	return strings.Contains(err.Error(), "ssh: unable to authenticate")
+}
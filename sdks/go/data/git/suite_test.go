@@ -1,12 +1,18 @@
 package git
 
 import (
+	"os"
+	"testing"
+
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
-	"testing"
+	"github.com/opctl/opctl/sdks/go/internal/httpclient"
 )
 
 func Test(t *testing.T) {
+	// mock tls servers rely on http.DefaultTransport; don't let an ambient CA bundle replace it
+	os.Unsetenv(httpclient.CABundleEnvVar)
+
 	RegisterFailHandler(Fail)
 	RunSpecs(t, "data/provider/git")
 }
@@ -0,0 +1,179 @@
+package git
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+
+	"github.com/blang/semver"
+	"github.com/go-git/go-git/v5"
+	"github.com/go-git/go-git/v5/config"
+	"github.com/go-git/go-git/v5/storage/memory"
+	"github.com/opctl/opctl/sdks/go/model"
+)
+
+// versionRangePrefixes are the chars versions which are semver ranges start w/
+const versionRangePrefixes = "^~<>=!"
+
+// resolvedVersionsCtxKey holds the versions version ranges resolved to in scope of a run
+type resolvedVersionsCtxKey struct{}
+
+// WithResolvedVersions returns a copy of ctx in which each version range is resolved once;
+// subsequent resolves of the range (i.e. by child ops of a run) use the same version.
+func WithResolvedVersions(
+	ctx context.Context,
+) context.Context {
+	return context.WithValue(ctx, resolvedVersionsCtxKey{}, &sync.Map{})
+}
+
+// isVersionRange reports whether version is a semver range rather than a commit SHA, tag, or branch
+func isVersionRange(
+	version string,
+) bool {
+	return version != "" && strings.ContainsAny(version[:1], versionRangePrefixes)
+}
+
+// parseVersionRange parses a semver range.
+//
+// In addition to ranges supported by github.com/blang/semver, ranges MAY be:
+//  - ^MAJOR[.MINOR[.PATCH]]; allows changes which don't modify the left most non zero part
+//  - ~MAJOR[.MINOR[.PATCH]]; allows patch changes if minor is specified, otherwise minor changes
+func parseVersionRange(
+	versionRange string,
+) (semver.Range, error) {
+	operator := versionRange[:1]
+	if operator != "^" && operator != "~" {
+		return semver.ParseRange(versionRange)
+	}
+
+	versionParts := strings.Split(versionRange[1:], ".")
+	if len(versionParts) > 3 {
+		return nil, fmt.Errorf("invalid version range '%v'", versionRange)
+	}
+
+	lower, err := semver.ParseTolerant(versionRange[1:])
+	if err != nil {
+		return nil, fmt.Errorf("invalid version range '%v': %w", versionRange, err)
+	}
+
+	var upper semver.Version
+	switch {
+	case operator == "~" && len(versionParts) == 1,
+		operator == "^" && (lower.Major != 0 || len(versionParts) == 1):
+		upper = semver.Version{Major: lower.Major + 1}
+	case operator == "~",
+		operator == "^" && (lower.Minor != 0 || len(versionParts) == 2):
+		upper = semver.Version{Major: lower.Major, Minor: lower.Minor + 1}
+	default:
+		upper = semver.Version{Major: lower.Major, Minor: lower.Minor, Patch: lower.Patch + 1}
+	}
+
+	return semver.ParseRange(fmt.Sprintf(">=%v <%v", lower, upper))
+}
+
+// resolveVersionRange returns 'dataRef' w/ its version range (if any) replaced by the highest matching tag.
+// Pre-release tags are never matched.
+func resolveVersionRange(
+	ctx context.Context,
+	dataRef string,
+	authOpts *model.Creds,
+) (string, error) {
+	parsedRef, err := parseRef(dataRef)
+	if err != nil || !isVersionRange(parsedRef.Version) {
+		return dataRef, nil
+	}
+
+	resolvedVersions, _ := ctx.Value(resolvedVersionsCtxKey{}).(*sync.Map)
+	resolvedVersionsKey := fmt.Sprintf("%v#%v", parsedRef.CloneURL, parsedRef.Version)
+	if resolvedVersions != nil {
+		if resolvedVersion, ok := resolvedVersions.Load(resolvedVersionsKey); ok {
+			return replaceVersion(dataRef, resolvedVersion.(string)), nil
+		}
+	}
+
+	versionRange, err := parseVersionRange(parsedRef.Version)
+	if err != nil {
+		return "", err
+	}
+
+	tags, err := listTags(parsedRef, authOpts)
+	if err != nil {
+		return "", err
+	}
+
+	var (
+		resolvedVersion string
+		highest         semver.Version
+	)
+	for _, tag := range tags {
+		version, err := semver.ParseTolerant(tag)
+		if err != nil || len(version.Pre) > 0 || !versionRange(version) {
+			continue
+		}
+
+		if resolvedVersion == "" || version.GT(highest) {
+			resolvedVersion = tag
+			highest = version
+		}
+	}
+
+	if resolvedVersion == "" {
+		return "", fmt.Errorf("no version matching \"%s\" found", parsedRef.Version)
+	}
+
+	if resolvedVersions != nil {
+		// concurrent resolves may race; all use whichever was stored first
+		actualResolvedVersion, _ := resolvedVersions.LoadOrStore(resolvedVersionsKey, resolvedVersion)
+		resolvedVersion = actualResolvedVersion.(string)
+	}
+
+	return replaceVersion(dataRef, resolvedVersion), nil
+}
+
+// listTags lists the tags of the remote repo of 'parsedRef'
+func listTags(
+	parsedRef *ref,
+	authOpts *model.Creds,
+) ([]string, error) {
+	cloneOptions, err := newCloneOptions(parsedRef, authOpts)
+	if err != nil {
+		return nil, err
+	}
+
+	remote := git.NewRemote(
+		memory.NewStorage(),
+		&config.RemoteConfig{
+			Name: git.DefaultRemoteName,
+			URLs: []string{cloneOptions.URL},
+		},
+	)
+
+	remoteRefs, err := remote.List(&git.ListOptions{
+		Auth: cloneOptions.Auth,
+	})
+	if err != nil {
+		return nil, toDataProviderErr(err)
+	}
+
+	tags := []string{}
+	for _, remoteRef := range remoteRefs {
+		if remoteRef.Name().IsTag() {
+			tags = append(tags, remoteRef.Name().Short())
+		}
+	}
+
+	return tags, nil
+}
+
+// replaceVersion returns 'dataRef' w/ its version replaced by 'version'
+func replaceVersion(
+	dataRef string,
+	version string,
+) string {
+	fragmentIndex := strings.Index(dataRef, "#")
+	fragmentParts := strings.SplitN(dataRef[fragmentIndex+1:], "/", 2)
+	fragmentParts[0] = version
+
+	return fmt.Sprintf("%v#%v", dataRef[:fragmentIndex], strings.Join(fragmentParts, "/"))
+}
@@ -0,0 +1,172 @@
+package git
+
+import (
+	"context"
+	"fmt"
+	"path/filepath"
+
+	"github.com/blang/semver"
+	"github.com/go-git/go-git/v5"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Context("versionRange", func() {
+	Context("isVersionRange", func() {
+		It("should return true for ranges", func() {
+			for _, version := range []string{"^1.4", "~2.0", ">=1.0.0 <2.0.0"} {
+				Expect(isVersionRange(version)).To(BeTrue(), version)
+			}
+		})
+		It("should return false for tags, branches & empty", func() {
+			for _, version := range []string{"1.4.0", "main", ""} {
+				Expect(isVersionRange(version)).To(BeFalse(), version)
+			}
+		})
+	})
+	Context("parseVersionRange", func() {
+		It("should return expected ranges", func() {
+			for versionRange, expected := range map[string]struct {
+				matching    []string
+				notMatching []string
+			}{
+				"^1.4":           {[]string{"1.4.0", "1.9.3"}, []string{"1.3.9", "2.0.0"}},
+				"^0.4":           {[]string{"0.4.0", "0.4.9"}, []string{"0.3.0", "0.5.0"}},
+				"^0.0.3":         {[]string{"0.0.3"}, []string{"0.0.2", "0.0.4"}},
+				"~2":             {[]string{"2.0.0", "2.9.0"}, []string{"1.9.0", "3.0.0"}},
+				"~2.0":           {[]string{"2.0.0", "2.0.9"}, []string{"1.9.0", "2.1.0"}},
+				"~2.0.1":         {[]string{"2.0.1", "2.0.9"}, []string{"2.0.0", "2.1.0"}},
+				">=1.0.0 <2.0.0": {[]string{"1.0.0", "1.9.9"}, []string{"0.9.0", "2.0.0"}},
+			} {
+				/* act */
+				actualRange, actualErr := parseVersionRange(versionRange)
+
+				/* assert */
+				Expect(actualErr).To(BeNil())
+				for _, version := range expected.matching {
+					Expect(actualRange(semver.MustParse(version))).To(BeTrue(), versionRange+" "+version)
+				}
+				for _, version := range expected.notMatching {
+					Expect(actualRange(semver.MustParse(version))).To(BeFalse(), versionRange+" "+version)
+				}
+			}
+		})
+	})
+	Context("parseVersionRange invalid", func() {
+		It("should return expected error", func() {
+			/* act */
+			_, actualErr := parseVersionRange("^1.2.3.4")
+
+			/* assert */
+			Expect(actualErr).To(MatchError("invalid version range '^1.2.3.4'"))
+		})
+	})
+	Context("replaceVersion", func() {
+		It("should return expected result", func() {
+			for dataRef, expected := range map[string]string{
+				"github.com/org/ops#^1.4":         "github.com/org/ops#1.4.2",
+				"github.com/org/ops#~1.4/some/op": "github.com/org/ops#1.4.2/some/op",
+				"git@host.com:org/ops#^1/op":      "git@host.com:org/ops#1.4.2/op",
+			} {
+				Expect(replaceVersion(dataRef, "1.4.2")).To(Equal(expected))
+			}
+		})
+	})
+	Context("resolveVersionRange", func() {
+		var repoPath string
+		BeforeEach(func() {
+			repoPath, _ = newTestRepo("name: test")
+
+			repo, err := git.PlainOpen(repoPath)
+			if err != nil {
+				panic(err)
+			}
+
+			head, err := repo.Head()
+			if err != nil {
+				panic(err)
+			}
+
+			for _, tag := range []string{"1.4.2", "v1.5.0", "1.6.0-beta", "2.0.0", "notSemver"} {
+				if _, err := repo.CreateTag(tag, head.Hash(), nil); err != nil {
+					panic(err)
+				}
+			}
+		})
+		Context("version isn't a range", func() {
+			It("should return dataRef", func() {
+				/* arrange */
+				providedRef := fmt.Sprintf("file://%v#1.0.0/op", filepath.ToSlash(repoPath))
+
+				/* act */
+				actualRef, actualErr := resolveVersionRange(context.Background(), providedRef, nil)
+
+				/* assert */
+				Expect(actualErr).To(BeNil())
+				Expect(actualRef).To(Equal(providedRef))
+			})
+		})
+		Context("version is a range", func() {
+			It("should return dataRef w/ highest matching non pre-release tag", func() {
+				/* arrange */
+				providedRef := fmt.Sprintf("file://%v#^1.4/op", filepath.ToSlash(repoPath))
+
+				/* act */
+				actualRef, actualErr := resolveVersionRange(context.Background(), providedRef, nil)
+
+				/* assert */
+				Expect(actualErr).To(BeNil())
+				Expect(actualRef).To(Equal(fmt.Sprintf("file://%v#v1.5.0/op", filepath.ToSlash(repoPath))))
+			})
+		})
+		Context("no tag matches range", func() {
+			It("should return expected error", func() {
+				/* arrange */
+				providedRef := fmt.Sprintf("file://%v#^3", filepath.ToSlash(repoPath))
+
+				/* act */
+				_, actualErr := resolveVersionRange(context.Background(), providedRef, nil)
+
+				/* assert */
+				Expect(actualErr).To(MatchError(`no version matching "^3" found`))
+			})
+		})
+		Context("ctx w/ resolved versions", func() {
+			It("should return same version after new tags match", func() {
+				/* arrange */
+				providedCtx := WithResolvedVersions(context.Background())
+				providedRef := fmt.Sprintf("file://%v#^2", filepath.ToSlash(repoPath))
+
+				firstRef, err := resolveVersionRange(providedCtx, providedRef, nil)
+				if err != nil {
+					panic(err)
+				}
+
+				repo, err := git.PlainOpen(repoPath)
+				if err != nil {
+					panic(err)
+				}
+
+				head, err := repo.Head()
+				if err != nil {
+					panic(err)
+				}
+
+				if _, err := repo.CreateTag("2.1.0", head.Hash(), nil); err != nil {
+					panic(err)
+				}
+
+				/* act */
+				actualRef, actualErr := resolveVersionRange(providedCtx, providedRef, nil)
+
+				/* assert */
+				Expect(actualErr).To(BeNil())
+				Expect(actualRef).To(Equal(firstRef))
+
+				uncachedRef, err := resolveVersionRange(context.Background(), providedRef, nil)
+				Expect(err).To(BeNil())
+				Expect(uncachedRef).To(Equal(fmt.Sprintf("file://%v#2.1.0", filepath.ToSlash(repoPath))))
+			})
+		})
+	})
+})
@@ -0,0 +1,25 @@
+package oci
+
+import (
+	"github.com/opctl/opctl/sdks/go/model"
+)
+
+func newHandle(
+	cachedHandle model.DataHandle,
+	dataRef string,
+) model.DataHandle {
+	return handle{
+		DataHandle: cachedHandle,
+		dataRef:    dataRef,
+	}
+}
+
+// handle allows interacting w/ data sourced from an OCI registry; data is read from where it's cached
+type handle struct {
+	model.DataHandle
+	dataRef string
+}
+
+func (oh handle) Ref() string {
+	return oh.dataRef
+}
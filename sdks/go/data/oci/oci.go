@@ -0,0 +1,104 @@
+package oci
+
+import (
+	"context"
+	"os"
+
+	"github.com/containers/image/v5/types"
+	"github.com/opctl/opctl/sdks/go/data/cache"
+	"github.com/opctl/opctl/sdks/go/data/fs"
+	"github.com/opctl/opctl/sdks/go/model"
+	"github.com/pkg/errors"
+	"golang.org/x/sync/singleflight"
+)
+
+// resolveSingleFlightGroup is used to ensure resolves don't race across provider instances
+var resolveSingleFlightGroup singleflight.Group
+
+// New returns a data provider which sources ops from OCI registries
+//
+// nil pullCreds will be ignored; credentials of the docker & podman config files are used instead (if any).
+func New(
+	basePath string,
+	pullCreds *model.Creds,
+) model.DataProvider {
+	return _oci{
+		localFSProvider: fs.New(basePath),
+		basePath:        basePath,
+		systemContext:   newSystemContext(pullCreds),
+	}
+}
+
+type _oci struct {
+	// composed of fsProvider
+	localFSProvider model.DataProvider
+	basePath        string
+	systemContext   *types.SystemContext
+}
+
+func (op _oci) Label() string {
+	return "oci"
+}
+
+// TryResolve resolves refs of the form oci://registry/repository:tag or oci://registry/repository@digest.
+//
+// Artifacts are cached once pulled; like git tags, tags are expected to be immutable.
+func (op _oci) TryResolve(
+	ctx context.Context,
+	dataRef string,
+) (model.DataHandle, error) {
+	parsedRef, err := parseRef(dataRef)
+	if err != nil {
+		return nil, errors.Wrap(err, "invalid oci ref")
+	}
+
+	// attempt to resolve within singleFlight.Group to ensure concurrent resolves don't race
+	handle, err, _ := resolveSingleFlightGroup.Do(
+		dataRef,
+		func() (interface{}, error) {
+			// attempt to resolve from cache
+			opPath := parsedRef.ToPath(op.basePath)
+			if _, err := os.Stat(opPath); os.IsNotExist(err) {
+				// attempt pull if cache miss
+				if err := pull(ctx, op.basePath, parsedRef, op.systemContext); err != nil {
+					return nil, err
+				}
+			} else if err != nil {
+				return nil, err
+			} else {
+				info, err := cache.ReadInfo(opPath)
+				if err != nil {
+					return nil, err
+				}
+
+				if err := info.MarkUsed(opPath); err != nil {
+					return nil, err
+				}
+			}
+
+			handle, err := op.localFSProvider.TryResolve(ctx, parsedRef.CacheRef())
+			if err != nil {
+				return nil, err
+			}
+			return newHandle(handle, dataRef), nil
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+	return handle.(model.DataHandle), nil
+}
+
+// newSystemContext returns a context authenticating w/ 'creds' if not nil
+func newSystemContext(
+	creds *model.Creds,
+) *types.SystemContext {
+	systemContext := &types.SystemContext{}
+	if creds != nil && creds.Username != "" {
+		systemContext.DockerAuthConfig = &types.DockerAuthConfig{
+			Username: creds.Username,
+			Password: creds.Password,
+		}
+	}
+	return systemContext
+}
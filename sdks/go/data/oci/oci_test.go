@@ -0,0 +1,236 @@
+package oci
+
+import (
+	"context"
+	"fmt"
+	"io/ioutil"
+	"time"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/opctl/opctl/sdks/go/data/cache"
+	"github.com/opctl/opctl/sdks/go/data/fs"
+	"github.com/opctl/opctl/sdks/go/model"
+)
+
+var _ = Context("_oci", func() {
+	var (
+		basePath string
+		registry *testRegistry
+	)
+	BeforeEach(func() {
+		var err error
+		basePath, err = ioutil.TempDir("", "")
+		if err != nil {
+			panic(err)
+		}
+
+		registry = newTestRegistry(nil)
+	})
+	AfterEach(func() {
+		registry.Close()
+	})
+	newObjectUnderTest := func(creds *model.Creds) model.DataProvider {
+		return _oci{
+			localFSProvider: fs.New(basePath),
+			basePath:        basePath,
+			systemContext:   newTestSystemContext(creds),
+		}
+	}
+	Context("TryResolve", func() {
+		Context("ref isn't an oci ref", func() {
+			It("should return expected error", func() {
+				/* act */
+				_, actualErr := newObjectUnderTest(nil).TryResolve(
+					context.Background(),
+					"github.com/opctl/opctl#1.0.0",
+				)
+
+				/* assert */
+				Expect(actualErr).To(MatchError("invalid oci ref: missing oci:// prefix"))
+			})
+		})
+		Context("op published", func() {
+			It("should return expected handle", func() {
+				/* arrange */
+				providedRef := fmt.Sprintf("oci://%v/ops/build:1.2.0", registry.Host())
+				if _, err := push(context.Background(), newTestOp("name: build"), providedRef, newTestSystemContext(nil)); err != nil {
+					panic(err)
+				}
+
+				/* act */
+				actualHandle, actualErr := newObjectUnderTest(nil).TryResolve(
+					context.Background(),
+					providedRef,
+				)
+
+				/* assert */
+				Expect(actualErr).To(BeNil())
+				Expect(actualHandle.Ref()).To(Equal(providedRef))
+
+				actualOpFile, err := ioutil.ReadFile(*actualHandle.Path() + "/op.yml")
+				Expect(err).To(BeNil())
+				Expect(string(actualOpFile)).To(Equal("name: build"))
+			})
+			It("should resolve from cache once pulled", func() {
+				/* arrange */
+				providedRef := fmt.Sprintf("oci://%v/ops/build:1.2.0", registry.Host())
+				if _, err := push(context.Background(), newTestOp("name: build"), providedRef, newTestSystemContext(nil)); err != nil {
+					panic(err)
+				}
+
+				objectUnderTest := newObjectUnderTest(nil)
+				if _, err := objectUnderTest.TryResolve(context.Background(), providedRef); err != nil {
+					panic(err)
+				}
+
+				/* act */
+				_, actualErr := objectUnderTest.TryResolve(
+					context.Background(),
+					providedRef,
+				)
+
+				/* assert */
+				Expect(actualErr).To(BeNil())
+				Expect(registry.ManifestPulls()).To(Equal(1))
+			})
+			It("should record pull & usage", func() {
+				/* arrange */
+				providedRef := fmt.Sprintf("oci://%v/ops/build:1.2.0", registry.Host())
+				if _, err := push(context.Background(), newTestOp("name: build"), providedRef, newTestSystemContext(nil)); err != nil {
+					panic(err)
+				}
+
+				parsedRef, err := parseRef(providedRef)
+				if err != nil {
+					panic(err)
+				}
+				opPath := parsedRef.ToPath(basePath)
+
+				objectUnderTest := newObjectUnderTest(nil)
+				if _, err := objectUnderTest.TryResolve(context.Background(), providedRef); err != nil {
+					panic(err)
+				}
+
+				pulledInfo, err := cache.ReadInfo(opPath)
+				if err != nil {
+					panic(err)
+				}
+
+				// last used long ago
+				pulledInfo.UsedAt = time.Now().Add(-48 * time.Hour)
+				if err := cache.WriteInfo(opPath, pulledInfo); err != nil {
+					panic(err)
+				}
+
+				/* act */
+				_, actualErr := objectUnderTest.TryResolve(
+					context.Background(),
+					providedRef,
+				)
+
+				/* assert */
+				Expect(actualErr).To(BeNil())
+
+				actualInfo, err := cache.ReadInfo(opPath)
+				Expect(err).To(BeNil())
+				Expect(actualInfo.PulledAt).To(BeTemporally("~", time.Now(), time.Minute))
+				Expect(actualInfo.UsedAt).To(BeTemporally("~", time.Now(), time.Minute))
+			})
+			It("should resolve by digest", func() {
+				/* arrange */
+				manifestDigest, err := push(
+					context.Background(),
+					newTestOp("name: build"),
+					fmt.Sprintf("oci://%v/ops/build:1.2.0", registry.Host()),
+					newTestSystemContext(nil),
+				)
+				if err != nil {
+					panic(err)
+				}
+
+				providedRef := fmt.Sprintf("oci://%v/ops/build@%v", registry.Host(), manifestDigest)
+
+				/* act */
+				actualHandle, actualErr := newObjectUnderTest(nil).TryResolve(
+					context.Background(),
+					providedRef,
+				)
+
+				/* assert */
+				Expect(actualErr).To(BeNil())
+				Expect(actualHandle.Ref()).To(Equal(providedRef))
+			})
+		})
+		Context("op not published", func() {
+			It("should return expected error", func() {
+				/* act */
+				_, actualErr := newObjectUnderTest(nil).TryResolve(
+					context.Background(),
+					fmt.Sprintf("oci://%v/ops/build:1.2.0", registry.Host()),
+				)
+
+				/* assert */
+				Expect(actualErr).To(MatchError(fmt.Sprintf("'%v/ops/build:1.2.0' not found", registry.Host())))
+			})
+		})
+		Context("artifact isn't an op", func() {
+			It("should return expected error", func() {
+				/* arrange */
+				registry.PutManifest(
+					"ops/build",
+					"1.2.0",
+					[]byte(`{"schemaVersion":2,"config":{"mediaType":"application/vnd.oci.image.config.v1+json","digest":"sha256:44136fa355b3678a1146ad16f7e8649e94fb4fc21fe77e8310c060f61caaff8a","size":2},"layers":[]}`),
+				)
+
+				/* act */
+				_, actualErr := newObjectUnderTest(nil).TryResolve(
+					context.Background(),
+					fmt.Sprintf("oci://%v/ops/build:1.2.0", registry.Host()),
+				)
+
+				/* assert */
+				Expect(actualErr).To(MatchError(fmt.Sprintf("'%v/ops/build:1.2.0' isn't an op", registry.Host())))
+			})
+		})
+		Context("registry requires creds", func() {
+			var providedRef string
+			BeforeEach(func() {
+				registry.Close()
+
+				creds := &model.Creds{Username: "user", Password: "pass"}
+				registry = newTestRegistry(creds)
+				providedRef = fmt.Sprintf("oci://%v/ops/build:1.2.0", registry.Host())
+
+				if _, err := push(context.Background(), newTestOp("name: build"), providedRef, newTestSystemContext(creds)); err != nil {
+					panic(err)
+				}
+			})
+			Context("creds not provided", func() {
+				It("should return expected error", func() {
+					/* act */
+					_, actualErr := newObjectUnderTest(nil).TryResolve(
+						context.Background(),
+						providedRef,
+					)
+
+					/* assert */
+					Expect(actualErr).To(Equal(model.ErrDataProviderAuthentication{}))
+				})
+			})
+			Context("creds provided", func() {
+				It("should return handle", func() {
+					/* act */
+					actualHandle, actualErr := newObjectUnderTest(&model.Creds{Username: "user", Password: "pass"}).TryResolve(
+						context.Background(),
+						providedRef,
+					)
+
+					/* assert */
+					Expect(actualErr).To(BeNil())
+					Expect(actualHandle.Ref()).To(Equal(providedRef))
+				})
+			})
+		})
+	})
+})
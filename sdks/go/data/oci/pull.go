@@ -0,0 +1,165 @@
+package oci
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+
+	"github.com/containers/image/v5/docker"
+	"github.com/containers/image/v5/docker/reference"
+	"github.com/containers/image/v5/manifest"
+	"github.com/containers/image/v5/pkg/blobinfocache/none"
+	"github.com/containers/image/v5/types"
+	"github.com/docker/distribution/registry/api/errcode"
+	v2 "github.com/docker/distribution/registry/api/v2"
+	"github.com/opctl/opctl/sdks/go/data/cache"
+	"github.com/opctl/opctl/sdks/go/internal/targz"
+	"github.com/opctl/opctl/sdks/go/model"
+	imgspecv1 "github.com/opencontainers/image-spec/specs-go/v1"
+	"github.com/pkg/errors"
+)
+
+// pull pulls 'parsedRef' to 'basePath' & records its cache.Info
+func pull(
+	ctx context.Context,
+	basePath string,
+	parsedRef *ref,
+	systemContext *types.SystemContext,
+) error {
+	imageRef, err := docker.NewReference(parsedRef.Named)
+	if err != nil {
+		return err
+	}
+
+	imageSource, err := imageRef.NewImageSource(ctx, systemContext)
+	if err != nil {
+		return toDataProviderErr(err, parsedRef)
+	}
+	defer imageSource.Close()
+
+	manifestBytes, _, err := imageSource.GetManifest(ctx, nil)
+	if err != nil {
+		return toDataProviderErr(err, parsedRef)
+	}
+
+	if canonical, ok := parsedRef.Named.(reference.Canonical); ok {
+		if isMatch, err := manifest.MatchesDigest(manifestBytes, canonical.Digest()); err != nil {
+			return err
+		} else if !isMatch {
+			return fmt.Errorf("manifest of '%v' doesn't match its digest", parsedRef.Named)
+		}
+	}
+
+	layer, err := getOpLayer(manifestBytes, parsedRef)
+	if err != nil {
+		return err
+	}
+
+	layerReader, _, err := imageSource.GetBlob(
+		ctx,
+		types.BlobInfo{Digest: layer.Digest, Size: layer.Size},
+		none.NoCache,
+	)
+	if err != nil {
+		return toDataProviderErr(err, parsedRef)
+	}
+	defer layerReader.Close()
+
+	opPath := parsedRef.ToPath(basePath)
+
+	if err := os.MkdirAll(filepath.Dir(opPath), 0777); err != nil {
+		return err
+	}
+
+	// extract beside opPath then move it in place so partially pulled data is never resolved
+	tmpPath, err := ioutil.TempDir(filepath.Dir(opPath), fmt.Sprintf(".%v.pull", filepath.Base(opPath)))
+	if err != nil {
+		return err
+	}
+	defer os.RemoveAll(tmpPath)
+
+	digestVerifier := layer.Digest.Verifier()
+	verifiedReader := io.TeeReader(layerReader, digestVerifier)
+
+	extractPath := filepath.Join(tmpPath, "op")
+	if err := targz.Extract(verifiedReader, extractPath); err != nil {
+		return err
+	}
+
+	// digest the remainder (i.e. tar padding) of the layer
+	if _, err := io.Copy(ioutil.Discard, verifiedReader); err != nil {
+		return err
+	}
+
+	if !digestVerifier.Verified() {
+		return fmt.Errorf("layer of '%v' doesn't match its digest", parsedRef.Named)
+	}
+
+	if err := os.RemoveAll(opPath); err != nil {
+		return err
+	}
+
+	if err := os.Rename(extractPath, opPath); err != nil {
+		return err
+	}
+
+	return cache.WriteInfo(opPath, cache.NewInfo())
+}
+
+// getOpLayer returns the layer containing the op of an artifact w/ manifest 'manifestBytes'
+func getOpLayer(
+	manifestBytes []byte,
+	parsedRef *ref,
+) (*imgspecv1.Descriptor, error) {
+	artifactManifest := imgspecv1.Manifest{}
+	if err := json.Unmarshal(manifestBytes, &artifactManifest); err != nil {
+		return nil, errors.Wrapf(err, "invalid manifest for '%v'", parsedRef.Named)
+	}
+
+	if artifactManifest.Annotations[artifactTypeAnnotation] != artifactTypeOp ||
+		len(artifactManifest.Layers) != 1 ||
+		artifactManifest.Layers[0].MediaType != imgspecv1.MediaTypeImageLayerGzip {
+		return nil, fmt.Errorf("'%v' isn't an op", parsedRef.Named)
+	}
+
+	return &artifactManifest.Layers[0], nil
+}
+
+// toDataProviderErr maps errors of registries to their model.DataProvider equivalent
+func toDataProviderErr(
+	err error,
+	parsedRef *ref,
+) error {
+	if errors.As(err, &docker.ErrUnauthorizedForCredentials{}) {
+		return model.ErrDataProviderAuthentication{}
+	}
+
+	var errorCodes []errcode.ErrorCode
+	switch cause := errors.Cause(err).(type) {
+	case errcode.Errors:
+		for _, causeErr := range cause {
+			if coder, ok := causeErr.(errcode.ErrorCoder); ok {
+				errorCodes = append(errorCodes, coder.ErrorCode())
+			}
+		}
+	case errcode.ErrorCoder:
+		errorCodes = append(errorCodes, cause.ErrorCode())
+	}
+
+	for _, errorCode := range errorCodes {
+		switch errorCode {
+		case errcode.ErrorCodeUnauthorized:
+			return model.ErrDataProviderAuthentication{}
+		case errcode.ErrorCodeDenied:
+			return model.ErrDataProviderAuthorization{}
+		case v2.ErrorCodeManifestUnknown, v2.ErrorCodeNameUnknown:
+			return fmt.Errorf("'%v' not found", parsedRef.Named)
+		}
+	}
+
+	return err
+}
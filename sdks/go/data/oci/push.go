@@ -0,0 +1,141 @@
+package oci
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+
+	"github.com/containers/image/v5/docker"
+	"github.com/containers/image/v5/pkg/blobinfocache/none"
+	"github.com/containers/image/v5/types"
+	"github.com/opctl/opctl/sdks/go/internal/targz"
+	"github.com/opctl/opctl/sdks/go/model"
+	"github.com/opencontainers/go-digest"
+	imgspecs "github.com/opencontainers/image-spec/specs-go"
+	imgspecv1 "github.com/opencontainers/image-spec/specs-go/v1"
+	"github.com/pkg/errors"
+)
+
+const (
+	// artifactTypeAnnotation annotates manifests w/ the type of opctl artifact they describe
+	artifactTypeAnnotation = "io.opctl.artifact.type"
+	artifactTypeOp         = "op"
+)
+
+// Push pushes the op at 'path' to 'dataRef' as an OCI artifact & returns the digest of its manifest
+// nil pushCreds will be ignored; credentials of the docker & podman config files are used instead (if any).
+//
+// Artifacts consist of a single gzipped tar layer containing the op dir; standard media types are used
+// so any OCI compliant registry can store them.
+//
+// expected errs:
+//  - ErrDataProviderAuthentication on authentication failure
+//  - ErrDataProviderAuthorization on authorization failure
+func Push(
+	ctx context.Context,
+	path string,
+	dataRef string,
+	pushCreds *model.Creds,
+) (string, error) {
+	return push(ctx, path, dataRef, newSystemContext(pushCreds))
+}
+
+func push(
+	ctx context.Context,
+	path string,
+	dataRef string,
+	systemContext *types.SystemContext,
+) (string, error) {
+	parsedRef, err := parseRef(dataRef)
+	if err != nil {
+		return "", errors.Wrap(err, "invalid oci ref")
+	}
+
+	// ops are small so buffer the layer; its digest must be known before it's pushed
+	layerBuffer := &bytes.Buffer{}
+	diffID, err := targz.Write(path, layerBuffer)
+	if err != nil {
+		return "", err
+	}
+
+	configBytes, err := json.Marshal(imgspecv1.Image{
+		RootFS: imgspecv1.RootFS{
+			Type:    "layers",
+			DiffIDs: []digest.Digest{diffID},
+		},
+	})
+	if err != nil {
+		return "", err
+	}
+
+	imageRef, err := docker.NewReference(parsedRef.Named)
+	if err != nil {
+		return "", err
+	}
+
+	imageDestination, err := imageRef.NewImageDestination(ctx, systemContext)
+	if err != nil {
+		return "", toDataProviderErr(err, parsedRef)
+	}
+	defer imageDestination.Close()
+
+	configInfo, err := putBlob(ctx, imageDestination, configBytes, true)
+	if err != nil {
+		return "", toDataProviderErr(err, parsedRef)
+	}
+
+	layerInfo, err := putBlob(ctx, imageDestination, layerBuffer.Bytes(), false)
+	if err != nil {
+		return "", toDataProviderErr(err, parsedRef)
+	}
+
+	manifestBytes, err := json.Marshal(imgspecv1.Manifest{
+		Versioned: imgspecs.Versioned{SchemaVersion: 2},
+		Config: imgspecv1.Descriptor{
+			MediaType: imgspecv1.MediaTypeImageConfig,
+			Digest:    configInfo.Digest,
+			Size:      configInfo.Size,
+		},
+		Layers: []imgspecv1.Descriptor{
+			{
+				MediaType: imgspecv1.MediaTypeImageLayerGzip,
+				Digest:    layerInfo.Digest,
+				Size:      layerInfo.Size,
+			},
+		},
+		Annotations: map[string]string{
+			artifactTypeAnnotation: artifactTypeOp,
+		},
+	})
+	if err != nil {
+		return "", err
+	}
+
+	if err := imageDestination.PutManifest(ctx, manifestBytes, nil); err != nil {
+		return "", toDataProviderErr(err, parsedRef)
+	}
+
+	if err := imageDestination.Commit(ctx, nil); err != nil {
+		return "", err
+	}
+
+	return digest.FromBytes(manifestBytes).String(), nil
+}
+
+func putBlob(
+	ctx context.Context,
+	imageDestination types.ImageDestination,
+	blob []byte,
+	isConfig bool,
+) (types.BlobInfo, error) {
+	return imageDestination.PutBlob(
+		ctx,
+		bytes.NewReader(blob),
+		types.BlobInfo{
+			Digest: digest.FromBytes(blob),
+			Size:   int64(len(blob)),
+		},
+		none.NoCache,
+		isConfig,
+	)
+}
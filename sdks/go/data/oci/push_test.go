@@ -0,0 +1,90 @@
+package oci
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"path/filepath"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/opctl/opctl/sdks/go/model"
+	imgspecv1 "github.com/opencontainers/image-spec/specs-go/v1"
+)
+
+// newTestOp creates an op dir containing 'opFile' & returns its path
+func newTestOp(
+	opFile string,
+) string {
+	opPath, err := ioutil.TempDir("", "")
+	if err != nil {
+		panic(err)
+	}
+
+	if err := ioutil.WriteFile(filepath.Join(opPath, "op.yml"), []byte(opFile), 0644); err != nil {
+		panic(err)
+	}
+
+	return opPath
+}
+
+var _ = Context("push", func() {
+	var registry *testRegistry
+	BeforeEach(func() {
+		registry = newTestRegistry(nil)
+	})
+	AfterEach(func() {
+		registry.Close()
+	})
+	Context("ref invalid", func() {
+		It("should return expected error", func() {
+			/* act */
+			_, actualErr := push(context.Background(), newTestOp("name: test"), "oci://host.com/ops/build", newTestSystemContext(nil))
+
+			/* assert */
+			Expect(actualErr).To(MatchError("invalid oci ref: missing tag or digest"))
+		})
+	})
+	It("should push op artifact", func() {
+		/* act */
+		actualDigest, actualErr := push(
+			context.Background(),
+			newTestOp("name: test"),
+			fmt.Sprintf("oci://%v/ops/build:1.2.0", registry.Host()),
+			newTestSystemContext(nil),
+		)
+
+		/* assert */
+		Expect(actualErr).To(BeNil())
+
+		manifestBytes := registry.manifests["ops/build:1.2.0"]
+		Expect(registry.manifests["ops/build@"+actualDigest]).To(Equal(manifestBytes))
+
+		actualManifest := imgspecv1.Manifest{}
+		Expect(json.Unmarshal(manifestBytes, &actualManifest)).To(BeNil())
+		Expect(actualManifest.Annotations).To(Equal(map[string]string{artifactTypeAnnotation: artifactTypeOp}))
+		Expect(actualManifest.Config.MediaType).To(Equal(imgspecv1.MediaTypeImageConfig))
+		Expect(actualManifest.Layers).To(HaveLen(1))
+		Expect(actualManifest.Layers[0].MediaType).To(Equal(imgspecv1.MediaTypeImageLayerGzip))
+		Expect(registry.blobs).To(HaveKey(actualManifest.Layers[0].Digest.String()))
+	})
+	Context("registry requires creds", func() {
+		It("should return expected error", func() {
+			/* arrange */
+			registry.Close()
+			registry = newTestRegistry(&model.Creds{Username: "user", Password: "pass"})
+
+			/* act */
+			_, actualErr := push(
+				context.Background(),
+				newTestOp("name: test"),
+				fmt.Sprintf("oci://%v/ops/build:1.2.0", registry.Host()),
+				newTestSystemContext(&model.Creds{Username: "user", Password: "wrong"}),
+			)
+
+			/* assert */
+			Expect(actualErr).To(Equal(model.ErrDataProviderAuthentication{}))
+		})
+	})
+})
@@ -0,0 +1,63 @@
+package oci
+
+import (
+	"fmt"
+	"path"
+	"path/filepath"
+	"strings"
+
+	"github.com/containers/image/v5/docker/reference"
+	"github.com/pkg/errors"
+)
+
+// refPrefix prefixes all refs to OCI artifacts
+const refPrefix = "oci://"
+
+type ref struct {
+	// Named is the normalized reference of the artifact; always has either a tag or digest
+	Named reference.Named
+}
+
+// parseRef parses refs of the form oci://registry/repository:tag or oci://registry/repository@digest
+func parseRef(
+	dataRef string,
+) (*ref, error) {
+	if !strings.HasPrefix(dataRef, refPrefix) {
+		return nil, fmt.Errorf("missing %v prefix", refPrefix)
+	}
+
+	named, err := reference.ParseNormalizedNamed(strings.TrimPrefix(dataRef, refPrefix))
+	if err != nil {
+		return nil, err
+	}
+
+	if reference.IsNameOnly(named) {
+		return nil, errors.New("missing tag or digest")
+	}
+
+	return &ref{Named: named}, nil
+}
+
+// version returns the digest of the Ref if it has one, otherwise its tag
+func (or ref) version() string {
+	if canonical, ok := or.Named.(reference.Canonical); ok {
+		// ':' isn't valid in windows paths
+		return strings.Replace(canonical.Digest().String(), ":", "-", 1)
+	}
+	return or.Named.(reference.Tagged).Tag()
+}
+
+// ToPath constructs a filesystem path for a Ref, assuming the provided base path
+func (or ref) ToPath(basePath string) string {
+	return filepath.Join(basePath, filepath.FromSlash(or.CacheRef()))
+}
+
+// CacheRef returns the ref, relative to a base path, data of the Ref is cached at
+func (or ref) CacheRef() string {
+	// prefixed so artifacts can't collide w/ git repos of the same name
+	return fmt.Sprintf(
+		"%v#%v",
+		path.Join("oci", reference.Domain(or.Named), reference.Path(or.Named)),
+		or.version(),
+	)
+}
@@ -0,0 +1,60 @@
+package oci
+
+import (
+	"path/filepath"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Context("parseRef", func() {
+	Context("ref missing prefix", func() {
+		It("should return expected error", func() {
+			/* act */
+			_, actualErr := parseRef("host.com/ops/build#1.0.0")
+
+			/* assert */
+			Expect(actualErr).To(MatchError("missing oci:// prefix"))
+		})
+	})
+	Context("ref missing tag or digest", func() {
+		It("should return expected error", func() {
+			/* act */
+			_, actualErr := parseRef("oci://host.com/ops/build")
+
+			/* assert */
+			Expect(actualErr).To(MatchError("missing tag or digest"))
+		})
+	})
+	Context("ref w/ tag", func() {
+		It("should return expected result", func() {
+			/* act */
+			actualRef, actualErr := parseRef("oci://host.com:5000/ops/build:1.2.0")
+
+			/* assert */
+			Expect(actualErr).To(BeNil())
+			Expect(actualRef.CacheRef()).To(Equal("oci/host.com:5000/ops/build#1.2.0"))
+			Expect(actualRef.ToPath("/base")).To(Equal(filepath.Join("/base", "oci", "host.com:5000", "ops", "build#1.2.0")))
+		})
+	})
+	Context("ref w/ digest", func() {
+		It("should return expected result", func() {
+			/* act */
+			actualRef, actualErr := parseRef("oci://host.com/ops/build@sha256:0123456789012345678901234567890123456789012345678901234567890123")
+
+			/* assert */
+			Expect(actualErr).To(BeNil())
+			Expect(actualRef.CacheRef()).To(Equal("oci/host.com/ops/build#sha256-0123456789012345678901234567890123456789012345678901234567890123"))
+		})
+	})
+	Context("ref w/out registry", func() {
+		It("should default to docker hub", func() {
+			/* act */
+			actualRef, actualErr := parseRef("oci://ops/build:1.2.0")
+
+			/* assert */
+			Expect(actualErr).To(BeNil())
+			Expect(actualRef.CacheRef()).To(Equal("oci/docker.io/ops/build#1.2.0"))
+		})
+	})
+})
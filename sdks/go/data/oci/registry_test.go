@@ -0,0 +1,177 @@
+package oci
+
+import (
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"regexp"
+	"strings"
+	"sync"
+
+	"github.com/containers/image/v5/types"
+	"github.com/opctl/opctl/sdks/go/model"
+	"github.com/opencontainers/go-digest"
+)
+
+var (
+	blobUploadsPathRegexp = regexp.MustCompile(`^/v2/(.+)/blobs/uploads/$`)
+	blobUploadPathRegexp  = regexp.MustCompile(`^/v2/(.+)/blobs/uploads/([^/]+)$`)
+	blobPathRegexp        = regexp.MustCompile(`^/v2/(.+)/blobs/([^/]+)$`)
+	manifestPathRegexp    = regexp.MustCompile(`^/v2/(.+)/manifests/([^/]+)$`)
+)
+
+// testRegistry is a minimal in memory stand-in for an OCI distribution registry
+type testRegistry struct {
+	*httptest.Server
+	// creds required of requests; nil if none are
+	creds *model.Creds
+
+	mutex         sync.Mutex
+	blobs         map[string][]byte
+	manifests     map[string][]byte
+	uploads       map[string][]byte
+	manifestPulls int
+}
+
+func newTestRegistry(
+	creds *model.Creds,
+) *testRegistry {
+	registry := &testRegistry{
+		creds:     creds,
+		blobs:     map[string][]byte{},
+		manifests: map[string][]byte{},
+		uploads:   map[string][]byte{},
+	}
+	registry.Server = httptest.NewServer(registry)
+	return registry
+}
+
+// Host returns the host:port the registry is listening on
+func (tr *testRegistry) Host() string {
+	return strings.TrimPrefix(tr.URL, "http://")
+}
+
+// PutManifest stores 'manifestBytes' as the manifest of 'name':'reference'
+func (tr *testRegistry) PutManifest(
+	name string,
+	reference string,
+	manifestBytes []byte,
+) {
+	tr.mutex.Lock()
+	defer tr.mutex.Unlock()
+
+	tr.manifests[name+":"+reference] = manifestBytes
+	tr.manifests[name+"@"+digest.FromBytes(manifestBytes).String()] = manifestBytes
+}
+
+// ManifestPulls returns the number of times manifests were pulled
+func (tr *testRegistry) ManifestPulls() int {
+	tr.mutex.Lock()
+	defer tr.mutex.Unlock()
+
+	return tr.manifestPulls
+}
+
+func (tr *testRegistry) ServeHTTP(
+	w http.ResponseWriter,
+	r *http.Request,
+) {
+	if tr.creds != nil {
+		username, password, ok := r.BasicAuth()
+		if !ok || username != tr.creds.Username || password != tr.creds.Password {
+			w.Header().Set("WWW-Authenticate", `Basic realm="test"`)
+			writeRegistryErr(w, http.StatusUnauthorized, "UNAUTHORIZED")
+			return
+		}
+	}
+
+	body, err := ioutil.ReadAll(r.Body)
+	if err != nil {
+		panic(err)
+	}
+
+	tr.mutex.Lock()
+	defer tr.mutex.Unlock()
+
+	switch {
+	case r.URL.Path == "/v2/":
+		w.WriteHeader(http.StatusOK)
+	case blobUploadsPathRegexp.MatchString(r.URL.Path) && r.Method == http.MethodPost:
+		uploadID := fmt.Sprintf("%d", len(tr.uploads))
+		tr.uploads[uploadID] = []byte{}
+		w.Header().Set("Location", r.URL.Path+uploadID)
+		w.WriteHeader(http.StatusAccepted)
+	case blobUploadPathRegexp.MatchString(r.URL.Path):
+		uploadID := blobUploadPathRegexp.FindStringSubmatch(r.URL.Path)[2]
+		tr.uploads[uploadID] = append(tr.uploads[uploadID], body...)
+		if r.Method == http.MethodPatch {
+			w.Header().Set("Location", r.URL.Path)
+			w.WriteHeader(http.StatusAccepted)
+			return
+		}
+		tr.blobs[r.URL.Query().Get("digest")] = tr.uploads[uploadID]
+		w.WriteHeader(http.StatusCreated)
+	case blobPathRegexp.MatchString(r.URL.Path):
+		blob, ok := tr.blobs[blobPathRegexp.FindStringSubmatch(r.URL.Path)[2]]
+		if !ok {
+			writeRegistryErr(w, http.StatusNotFound, "BLOB_UNKNOWN")
+			return
+		}
+		w.Header().Set("Content-Length", fmt.Sprintf("%d", len(blob)))
+		w.WriteHeader(http.StatusOK)
+		if r.Method == http.MethodGet {
+			w.Write(blob)
+		}
+	case manifestPathRegexp.MatchString(r.URL.Path):
+		matches := manifestPathRegexp.FindStringSubmatch(r.URL.Path)
+		separator := ":"
+		if strings.Contains(matches[2], ":") {
+			separator = "@"
+		}
+		key := matches[1] + separator + matches[2]
+
+		if r.Method == http.MethodPut {
+			tr.manifests[key] = body
+			tr.manifests[matches[1]+"@"+digest.FromBytes(body).String()] = body
+			w.WriteHeader(http.StatusCreated)
+			return
+		}
+
+		manifestBytes, ok := tr.manifests[key]
+		if !ok {
+			writeRegistryErr(w, http.StatusNotFound, "MANIFEST_UNKNOWN")
+			return
+		}
+		tr.manifestPulls++
+		w.Header().Set("Content-Type", "application/vnd.oci.image.manifest.v1+json")
+		w.Header().Set("Docker-Content-Digest", digest.FromBytes(manifestBytes).String())
+		w.WriteHeader(http.StatusOK)
+		w.Write(manifestBytes)
+	default:
+		w.WriteHeader(http.StatusNotFound)
+	}
+}
+
+func writeRegistryErr(
+	w http.ResponseWriter,
+	statusCode int,
+	code string,
+) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(statusCode)
+	fmt.Fprintf(w, `{"errors":[{"code":"%v","message":"%v"}]}`, code, strings.ToLower(code))
+}
+
+// newTestSystemContext returns a context for talking to a testRegistry
+func newTestSystemContext(
+	creds *model.Creds,
+) *types.SystemContext {
+	systemContext := newSystemContext(creds)
+	// testRegistry doesn't serve TLS
+	systemContext.DockerInsecureSkipTLSVerify = types.OptionalBoolTrue
+	// ignore creds of ambient auth files
+	systemContext.AuthFilePath = filepath.Join("/does/not/exist", "auth.json")
+	return systemContext
+}
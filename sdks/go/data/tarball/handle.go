@@ -0,0 +1,25 @@
+package tarball
+
+import (
+	"github.com/opctl/opctl/sdks/go/model"
+)
+
+func newHandle(
+	cachedHandle model.DataHandle,
+	dataRef string,
+) model.DataHandle {
+	return handle{
+		DataHandle: cachedHandle,
+		dataRef:    dataRef,
+	}
+}
+
+// handle allows interacting w/ data sourced from a tarball; data is read from where it's extracted
+type handle struct {
+	model.DataHandle
+	dataRef string
+}
+
+func (th handle) Ref() string {
+	return th.dataRef
+}
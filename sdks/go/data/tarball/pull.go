@@ -0,0 +1,118 @@
+package tarball
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"path/filepath"
+
+	"github.com/opctl/opctl/sdks/go/data/cache"
+	"github.com/opctl/opctl/sdks/go/internal/httpclient"
+	"github.com/opctl/opctl/sdks/go/internal/targz"
+	"github.com/opctl/opctl/sdks/go/model"
+)
+
+// pull downloads & extracts 'parsedRef' to 'basePath' & records its cache.Info
+//
+// Tarballs w/ a single top level dir (i.e. release archives) are extracted from within that dir.
+func pull(
+	ctx context.Context,
+	basePath string,
+	parsedRef *ref,
+	authOpts *model.Creds,
+) error {
+	req, err := http.NewRequest(http.MethodGet, parsedRef.URL.String(), nil)
+	if err != nil {
+		return err
+	}
+	req = req.WithContext(ctx)
+
+	if authOpts != nil && authOpts.Username != "" {
+		req.SetBasicAuth(authOpts.Username, authOpts.Password)
+	}
+
+	httpClient, err := httpclient.Get()
+	if err != nil {
+		return err
+	}
+
+	res, err := httpClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer res.Body.Close()
+
+	switch res.StatusCode {
+	case http.StatusOK:
+	case http.StatusUnauthorized:
+		return model.ErrDataProviderAuthentication{}
+	case http.StatusForbidden:
+		return model.ErrDataProviderAuthorization{}
+	case http.StatusNotFound:
+		return fmt.Errorf("'%v' not found", parsedRef.URL)
+	default:
+		return fmt.Errorf("unable to download '%v': %v", parsedRef.URL, res.Status)
+	}
+
+	opPath := parsedRef.ToPath(basePath)
+
+	if err := os.MkdirAll(filepath.Dir(opPath), 0777); err != nil {
+		return err
+	}
+
+	// extract beside opPath then move it in place so partially pulled data is never resolved
+	tmpPath, err := ioutil.TempDir(filepath.Dir(opPath), fmt.Sprintf(".%v.pull", filepath.Base(opPath)))
+	if err != nil {
+		return err
+	}
+	defer os.RemoveAll(tmpPath)
+
+	hash := sha256.New()
+	hashedReader := io.TeeReader(res.Body, hash)
+
+	extractPath := filepath.Join(tmpPath, "op")
+	if err := targz.Extract(hashedReader, extractPath); err != nil {
+		return err
+	}
+
+	// hash the remainder (i.e. tar padding) of the tarball
+	if _, err := io.Copy(ioutil.Discard, hashedReader); err != nil {
+		return err
+	}
+
+	if actualSHA256 := hex.EncodeToString(hash.Sum(nil)); parsedRef.SHA256 != "" && actualSHA256 != parsedRef.SHA256 {
+		return fmt.Errorf("sha256 of '%v' is %v; expected %v", parsedRef.URL, actualSHA256, parsedRef.SHA256)
+	}
+
+	srcPath, err := unwrap(extractPath)
+	if err != nil {
+		return err
+	}
+
+	if err := os.Rename(srcPath, opPath); err != nil {
+		return err
+	}
+
+	return cache.WriteInfo(opPath, cache.NewInfo())
+}
+
+// unwrap returns the path of the only child of 'path' if it's a dir, otherwise 'path'
+func unwrap(
+	path string,
+) (string, error) {
+	childFileInfos, err := ioutil.ReadDir(path)
+	if err != nil {
+		return "", err
+	}
+
+	if len(childFileInfos) == 1 && childFileInfos[0].IsDir() {
+		return filepath.Join(path, childFileInfos[0].Name()), nil
+	}
+
+	return path, nil
+}
@@ -0,0 +1,88 @@
+package tarball
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"net/url"
+	"path"
+	"path/filepath"
+	"regexp"
+	"strings"
+)
+
+// sha256FragmentPrefix prefixes fragments pinning the sha256 of tarballs
+const sha256FragmentPrefix = "sha256="
+
+var sha256Regexp = regexp.MustCompile(`^[0-9a-f]{64}$`)
+
+type ref struct {
+	// URL is the URL the tarball is downloaded from
+	URL *url.URL
+	// SHA256 is the hex encoded sha256 the tarball must have; empty if unpinned
+	SHA256 string
+}
+
+// parseRef parses refs of the form http(s)://host/path.tar.gz[#sha256=HEX]; .tgz is also supported
+func parseRef(
+	dataRef string,
+) (*ref, error) {
+	parsedURL, err := url.Parse(dataRef)
+	if err != nil {
+		return nil, err
+	}
+
+	if parsedURL.Scheme != "http" && parsedURL.Scheme != "https" {
+		return nil, errors.New("not an http(s) url")
+	}
+
+	if !strings.HasSuffix(parsedURL.Path, ".tar.gz") && !strings.HasSuffix(parsedURL.Path, ".tgz") {
+		return nil, errors.New("not a .tar.gz or .tgz url")
+	}
+
+	// the host & path are part of the path data is cached at so mustn't be able to escape it
+	if parsedURL.Host == "" || strings.Contains(parsedURL.Host, "..") {
+		return nil, fmt.Errorf("invalid host '%v'", parsedURL.Host)
+	}
+	if path.Clean(parsedURL.Path) != parsedURL.Path || strings.Contains(parsedURL.Path, "..") {
+		return nil, fmt.Errorf("invalid path '%v'; must be clean & not contain '..'", parsedURL.Path)
+	}
+
+	parsedRef := &ref{URL: parsedURL}
+	if parsedURL.Fragment != "" {
+		parsedRef.SHA256 = strings.ToLower(strings.TrimPrefix(parsedURL.Fragment, sha256FragmentPrefix))
+		if !strings.HasPrefix(parsedURL.Fragment, sha256FragmentPrefix) || !sha256Regexp.MatchString(parsedRef.SHA256) {
+			return nil, fmt.Errorf("invalid fragment '%v'; expected %vHEX", parsedURL.Fragment, sha256FragmentPrefix)
+		}
+		parsedURL.Fragment = ""
+	}
+
+	return parsedRef, nil
+}
+
+// ToPath constructs a filesystem path for a Ref, assuming the provided base path
+func (tr ref) ToPath(basePath string) string {
+	return filepath.Join(basePath, filepath.FromSlash(tr.CacheRef()))
+}
+
+// CacheRef returns the ref, relative to a base path, data of the Ref is cached at.
+// Query strings of pinned refs aren't part of it so (i.e. signed) URLs of the same tarball share it;
+// unpinned refs include a hash of their query string since it may select a different tarball (i.e. ?v=2).
+func (tr ref) CacheRef() string {
+	version := "unpinned"
+	switch {
+	case tr.SHA256 != "":
+		version = "sha256-" + tr.SHA256
+	case tr.URL.RawQuery != "":
+		querySHA256 := sha256.Sum256([]byte(tr.URL.RawQuery))
+		version = "unpinned-" + hex.EncodeToString(querySHA256[:])
+	}
+
+	// prefixed so tarballs can't collide w/ git repos of the same name
+	return fmt.Sprintf(
+		"%v#%v",
+		path.Join("tarball", tr.URL.Host, tr.URL.Path),
+		version,
+	)
+}
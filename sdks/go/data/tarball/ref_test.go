@@ -0,0 +1,104 @@
+package tarball
+
+import (
+	"path/filepath"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Context("parseRef", func() {
+	Context("ref isn't an http(s) url", func() {
+		It("should return expected error", func() {
+			/* act */
+			_, actualErr := parseRef("github.com/opctl/opctl#1.0.0")
+
+			/* assert */
+			Expect(actualErr).To(MatchError("not an http(s) url"))
+		})
+	})
+	Context("ref isn't a tarball url", func() {
+		It("should return expected error", func() {
+			/* act */
+			_, actualErr := parseRef("https://github.com/opctl/opctl#1.0.0")
+
+			/* assert */
+			Expect(actualErr).To(MatchError("not a .tar.gz or .tgz url"))
+		})
+	})
+	Context("ref path has '..' segments", func() {
+		It("should return expected error", func() {
+			/* act */
+			_, actualErr := parseRef("https://host.com/../../../build.tgz")
+
+			/* assert */
+			Expect(actualErr).To(MatchError("invalid path '/../../../build.tgz'; must be clean & not contain '..'"))
+		})
+	})
+	Context("ref path has encoded '..' segments", func() {
+		It("should return expected error", func() {
+			/* act */
+			_, actualErr := parseRef("https://host.com/ops/%2e%2e/%2e%2e/%2e%2e/build.tgz")
+
+			/* assert */
+			Expect(actualErr).To(MatchError("invalid path '/ops/../../../build.tgz'; must be clean & not contain '..'"))
+		})
+	})
+	Context("ref host is '..'", func() {
+		It("should return expected error", func() {
+			/* act */
+			_, actualErr := parseRef("https://../build.tgz")
+
+			/* assert */
+			Expect(actualErr).To(MatchError("invalid host '..'"))
+		})
+	})
+	Context("ref has invalid fragment", func() {
+		It("should return expected error", func() {
+			/* act */
+			_, actualErr := parseRef("https://host.com/ops/build.tgz#md5=abc")
+
+			/* assert */
+			Expect(actualErr).To(MatchError("invalid fragment 'md5=abc'; expected sha256=HEX"))
+		})
+	})
+	Context("ref w/out fragment", func() {
+		It("should return expected result", func() {
+			/* act */
+			actualRef, actualErr := parseRef("https://host.com:8443/ops/build-1.2.0.tar.gz")
+
+			/* assert */
+			Expect(actualErr).To(BeNil())
+			Expect(actualRef.URL.String()).To(Equal("https://host.com:8443/ops/build-1.2.0.tar.gz"))
+			Expect(actualRef.SHA256).To(BeEmpty())
+			Expect(actualRef.CacheRef()).To(Equal("tarball/host.com:8443/ops/build-1.2.0.tar.gz#unpinned"))
+			Expect(actualRef.ToPath("/base")).To(Equal(filepath.Join("/base", "tarball", "host.com:8443", "ops", "build-1.2.0.tar.gz#unpinned")))
+		})
+		Context("w/ query", func() {
+			It("should return refs w/ distinct CacheRefs per query", func() {
+				/* act */
+				actualRef1, actualErr1 := parseRef("https://host.com/ops/build.tar.gz?v=1")
+				actualRef2, actualErr2 := parseRef("https://host.com/ops/build.tar.gz?v=2")
+
+				/* assert */
+				Expect(actualErr1).To(BeNil())
+				Expect(actualErr2).To(BeNil())
+				Expect(actualRef1.URL.String()).To(Equal("https://host.com/ops/build.tar.gz?v=1"))
+				Expect(actualRef1.CacheRef()).To(HavePrefix("tarball/host.com/ops/build.tar.gz#unpinned-"))
+				Expect(actualRef1.CacheRef()).NotTo(Equal(actualRef2.CacheRef()))
+			})
+		})
+	})
+	Context("ref w/ sha256 fragment", func() {
+		It("should return expected result", func() {
+			/* act */
+			actualRef, actualErr := parseRef("https://host.com/ops/build.tgz?token=abc#sha256=E3B0C44298FC1C149AFBF4C8996FB92427AE41E4649B934CA495991B7852B855")
+
+			/* assert */
+			Expect(actualErr).To(BeNil())
+			Expect(actualRef.URL.String()).To(Equal("https://host.com/ops/build.tgz?token=abc"))
+			Expect(actualRef.SHA256).To(Equal("e3b0c44298fc1c149afbf4c8996fb92427ae41e4649b934ca495991b7852b855"))
+			Expect(actualRef.CacheRef()).To(Equal("tarball/host.com/ops/build.tgz#sha256-e3b0c44298fc1c149afbf4c8996fb92427ae41e4649b934ca495991b7852b855"))
+		})
+	})
+})
@@ -0,0 +1,88 @@
+package tarball
+
+import (
+	"context"
+	"os"
+
+	"github.com/opctl/opctl/sdks/go/data/cache"
+	"github.com/opctl/opctl/sdks/go/data/fs"
+	"github.com/opctl/opctl/sdks/go/model"
+	"github.com/pkg/errors"
+	"golang.org/x/sync/singleflight"
+)
+
+// resolveSingleFlightGroup is used to ensure resolves don't race across provider instances
+var resolveSingleFlightGroup singleflight.Group
+
+// New returns a data provider which sources ops from tarballs served over http(s)
+// nil pullCreds will be ignored
+func New(
+	basePath string,
+	pullCreds *model.Creds,
+) model.DataProvider {
+	return _tarball{
+		localFSProvider: fs.New(basePath),
+		basePath:        basePath,
+		pullCreds:       pullCreds,
+	}
+}
+
+type _tarball struct {
+	// composed of fsProvider
+	localFSProvider model.DataProvider
+	basePath        string
+	pullCreds       *model.Creds
+}
+
+func (tp _tarball) Label() string {
+	return "tarball"
+}
+
+// TryResolve resolves refs of the form http(s)://host/path.tar.gz[#sha256=HEX].
+//
+// Tarballs are downloaded once & cached by URL & sha256 (if any).
+func (tp _tarball) TryResolve(
+	ctx context.Context,
+	dataRef string,
+) (model.DataHandle, error) {
+	parsedRef, err := parseRef(dataRef)
+	if err != nil {
+		return nil, errors.Wrap(err, "invalid tarball ref")
+	}
+
+	// attempt to resolve within singleFlight.Group to ensure concurrent resolves don't race
+	handle, err, _ := resolveSingleFlightGroup.Do(
+		dataRef,
+		func() (interface{}, error) {
+			// attempt to resolve from cache
+			opPath := parsedRef.ToPath(tp.basePath)
+			if _, err := os.Stat(opPath); os.IsNotExist(err) {
+				// attempt pull if cache miss
+				if err := pull(ctx, tp.basePath, parsedRef, tp.pullCreds); err != nil {
+					return nil, err
+				}
+			} else if err != nil {
+				return nil, err
+			} else {
+				info, err := cache.ReadInfo(opPath)
+				if err != nil {
+					return nil, err
+				}
+
+				if err := info.MarkUsed(opPath); err != nil {
+					return nil, err
+				}
+			}
+
+			handle, err := tp.localFSProvider.TryResolve(ctx, parsedRef.CacheRef())
+			if err != nil {
+				return nil, err
+			}
+			return newHandle(handle, dataRef), nil
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+	return handle.(model.DataHandle), nil
+}
@@ -0,0 +1,287 @@
+package tarball
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"sync/atomic"
+	"time"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/opctl/opctl/sdks/go/data/cache"
+	"github.com/opctl/opctl/sdks/go/internal/targz"
+	"github.com/opctl/opctl/sdks/go/model"
+)
+
+// newTestTarball returns a tarball of an op w/ 'opFile' nested under 'prefix'
+func newTestTarball(
+	opFile string,
+	prefix string,
+) []byte {
+	rootPath, err := ioutil.TempDir("", "")
+	if err != nil {
+		panic(err)
+	}
+
+	opPath := filepath.Join(rootPath, prefix)
+	if err := os.MkdirAll(opPath, 0777); err != nil {
+		panic(err)
+	}
+
+	if err := ioutil.WriteFile(filepath.Join(opPath, "op.yml"), []byte(opFile), 0644); err != nil {
+		panic(err)
+	}
+
+	tarball := &bytes.Buffer{}
+	if _, err := targz.Write(rootPath, tarball); err != nil {
+		panic(err)
+	}
+
+	return tarball.Bytes()
+}
+
+var _ = Context("_tarball", func() {
+	var (
+		basePath  string
+		server    *httptest.Server
+		downloads int32
+		tarball   []byte
+		creds     *model.Creds
+	)
+	BeforeEach(func() {
+		var err error
+		basePath, err = ioutil.TempDir("", "")
+		if err != nil {
+			panic(err)
+		}
+
+		downloads = 0
+		tarball = newTestTarball("name: build", "")
+		creds = nil
+
+		server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if creds != nil {
+				if username, password, ok := r.BasicAuth(); !ok || username != creds.Username || password != creds.Password {
+					w.WriteHeader(http.StatusUnauthorized)
+					return
+				}
+			}
+
+			if r.URL.Path != "/ops/build.tar.gz" {
+				w.WriteHeader(http.StatusNotFound)
+				return
+			}
+
+			atomic.AddInt32(&downloads, 1)
+			w.Write(tarball)
+		}))
+	})
+	AfterEach(func() {
+		server.Close()
+	})
+	Context("TryResolve", func() {
+		Context("ref isn't a tarball ref", func() {
+			It("should return expected error", func() {
+				/* act */
+				_, actualErr := New(basePath, nil).TryResolve(
+					context.Background(),
+					"github.com/opctl/opctl#1.0.0",
+				)
+
+				/* assert */
+				Expect(actualErr).To(MatchError("invalid tarball ref: not an http(s) url"))
+			})
+		})
+		Context("tarball exists", func() {
+			It("should return expected handle", func() {
+				/* arrange */
+				providedRef := server.URL + "/ops/build.tar.gz"
+
+				/* act */
+				actualHandle, actualErr := New(basePath, nil).TryResolve(
+					context.Background(),
+					providedRef,
+				)
+
+				/* assert */
+				Expect(actualErr).To(BeNil())
+				Expect(actualHandle.Ref()).To(Equal(providedRef))
+
+				actualOpFile, err := ioutil.ReadFile(filepath.Join(*actualHandle.Path(), "op.yml"))
+				Expect(err).To(BeNil())
+				Expect(string(actualOpFile)).To(Equal("name: build"))
+			})
+			It("should resolve from cache once pulled", func() {
+				/* arrange */
+				providedRef := server.URL + "/ops/build.tar.gz"
+				objectUnderTest := New(basePath, nil)
+
+				if _, err := objectUnderTest.TryResolve(context.Background(), providedRef); err != nil {
+					panic(err)
+				}
+
+				/* act */
+				_, actualErr := objectUnderTest.TryResolve(
+					context.Background(),
+					providedRef,
+				)
+
+				/* assert */
+				Expect(actualErr).To(BeNil())
+				Expect(atomic.LoadInt32(&downloads)).To(Equal(int32(1)))
+			})
+			It("should record pull & usage", func() {
+				/* arrange */
+				providedRef := server.URL + "/ops/build.tar.gz"
+
+				parsedRef, err := parseRef(providedRef)
+				if err != nil {
+					panic(err)
+				}
+				opPath := parsedRef.ToPath(basePath)
+
+				objectUnderTest := New(basePath, nil)
+				if _, err := objectUnderTest.TryResolve(context.Background(), providedRef); err != nil {
+					panic(err)
+				}
+
+				pulledInfo, err := cache.ReadInfo(opPath)
+				if err != nil {
+					panic(err)
+				}
+
+				// last used long ago
+				pulledInfo.UsedAt = time.Now().Add(-48 * time.Hour)
+				if err := cache.WriteInfo(opPath, pulledInfo); err != nil {
+					panic(err)
+				}
+
+				/* act */
+				_, actualErr := objectUnderTest.TryResolve(
+					context.Background(),
+					providedRef,
+				)
+
+				/* assert */
+				Expect(actualErr).To(BeNil())
+
+				actualInfo, err := cache.ReadInfo(opPath)
+				Expect(err).To(BeNil())
+				Expect(actualInfo.PulledAt).To(BeTemporally("~", time.Now(), time.Minute))
+				Expect(actualInfo.UsedAt).To(BeTemporally("~", time.Now(), time.Minute))
+			})
+			Context("tarball has single top level dir", func() {
+				It("should return handle to the dir", func() {
+					/* arrange */
+					tarball = newTestTarball("name: build", "build-1.2.0")
+
+					/* act */
+					actualHandle, actualErr := New(basePath, nil).TryResolve(
+						context.Background(),
+						server.URL+"/ops/build.tar.gz",
+					)
+
+					/* assert */
+					Expect(actualErr).To(BeNil())
+
+					actualOpFile, err := ioutil.ReadFile(filepath.Join(*actualHandle.Path(), "op.yml"))
+					Expect(err).To(BeNil())
+					Expect(string(actualOpFile)).To(Equal("name: build"))
+				})
+			})
+			Context("sha256 matches", func() {
+				It("should return handle", func() {
+					/* arrange */
+					sha256Sum := sha256.Sum256(tarball)
+					providedRef := fmt.Sprintf("%v/ops/build.tar.gz#sha256=%v", server.URL, hex.EncodeToString(sha256Sum[:]))
+
+					/* act */
+					actualHandle, actualErr := New(basePath, nil).TryResolve(
+						context.Background(),
+						providedRef,
+					)
+
+					/* assert */
+					Expect(actualErr).To(BeNil())
+					Expect(actualHandle.Ref()).To(Equal(providedRef))
+				})
+			})
+			Context("sha256 doesn't match", func() {
+				It("should return expected error & not cache tarball", func() {
+					/* arrange */
+					sha256Sum := sha256.Sum256(tarball)
+					providedSHA256 := "e3b0c44298fc1c149afbf4c8996fb92427ae41e4649b934ca495991b7852b855"
+					providedRef := fmt.Sprintf("%v/ops/build.tar.gz#sha256=%v", server.URL, providedSHA256)
+
+					/* act */
+					_, actualErr := New(basePath, nil).TryResolve(
+						context.Background(),
+						providedRef,
+					)
+
+					/* assert */
+					Expect(actualErr).To(MatchError(fmt.Sprintf(
+						"sha256 of '%v/ops/build.tar.gz' is %v; expected %v",
+						server.URL,
+						hex.EncodeToString(sha256Sum[:]),
+						providedSHA256,
+					)))
+
+					parsedRef, err := parseRef(providedRef)
+					Expect(err).To(BeNil())
+					_, err = os.Stat(parsedRef.ToPath(basePath))
+					Expect(os.IsNotExist(err)).To(BeTrue())
+				})
+			})
+		})
+		Context("tarball doesn't exist", func() {
+			It("should return expected error", func() {
+				/* act */
+				_, actualErr := New(basePath, nil).TryResolve(
+					context.Background(),
+					server.URL+"/ops/missing.tgz",
+				)
+
+				/* assert */
+				Expect(actualErr).To(MatchError(fmt.Sprintf("'%v/ops/missing.tgz' not found", server.URL)))
+			})
+		})
+		Context("server requires creds", func() {
+			BeforeEach(func() {
+				creds = &model.Creds{Username: "user", Password: "pass"}
+			})
+			Context("creds not provided", func() {
+				It("should return expected error", func() {
+					/* act */
+					_, actualErr := New(basePath, nil).TryResolve(
+						context.Background(),
+						server.URL+"/ops/build.tar.gz",
+					)
+
+					/* assert */
+					Expect(actualErr).To(Equal(model.ErrDataProviderAuthentication{}))
+				})
+			})
+			Context("creds provided", func() {
+				It("should return handle", func() {
+					/* act */
+					_, actualErr := New(basePath, &model.Creds{Username: "user", Password: "pass"}).TryResolve(
+						context.Background(),
+						server.URL+"/ops/build.tar.gz",
+					)
+
+					/* assert */
+					Expect(actualErr).To(BeNil())
+				})
+			})
+		})
+	})
+})
@@ -0,0 +1,63 @@
+// Package httpclient provides the http client data is pulled w/
+package httpclient
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"sync"
+)
+
+// CABundleEnvVar is the env var specifying a CA bundle to trust; the one git itself uses
+const CABundleEnvVar = "GIT_SSL_CAINFO"
+
+var (
+	getOnce   sync.Once
+	getClient *http.Client
+	getErr    error
+)
+
+// Get returns a client trusting certs signed by the CA bundle at CABundleEnvVar (in addition to system roots);
+// http.DefaultClient if CABundleEnvVar isn't set.
+//
+// The CA bundle is read once per process.
+func Get() (*http.Client, error) {
+	getOnce.Do(func() {
+		caBundlePath, ok := os.LookupEnv(CABundleEnvVar)
+		if !ok {
+			getClient = http.DefaultClient
+			return
+		}
+
+		getClient, getErr = newClient(caBundlePath)
+	})
+
+	return getClient, getErr
+}
+
+// newClient returns a client trusting certs signed by the CA bundle at caBundlePath (in addition to system roots)
+func newClient(
+	caBundlePath string,
+) (*http.Client, error) {
+	caBundle, err := ioutil.ReadFile(caBundlePath)
+	if err != nil {
+		return nil, err
+	}
+
+	rootCAs, err := x509.SystemCertPool()
+	if err != nil {
+		rootCAs = x509.NewCertPool()
+	}
+
+	if !rootCAs.AppendCertsFromPEM(caBundle) {
+		return nil, fmt.Errorf("invalid %v: no certs found in '%v'", CABundleEnvVar, caBundlePath)
+	}
+
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	transport.TLSClientConfig = &tls.Config{RootCAs: rootCAs}
+
+	return &http.Client{Transport: transport}, nil
+}
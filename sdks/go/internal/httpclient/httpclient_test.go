@@ -0,0 +1,63 @@
+package httpclient
+
+import (
+	"encoding/pem"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Context("newClient", func() {
+	It("should trust certs signed by CA bundle", func() {
+		/* arrange */
+		server := httptest.NewTLSServer(
+			http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}),
+		)
+		defer server.Close()
+
+		tmpDir, err := ioutil.TempDir("", "")
+		if err != nil {
+			panic(err)
+		}
+
+		caBundlePath := filepath.Join(tmpDir, "ca.pem")
+		caBundle := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: server.Certificate().Raw})
+		if err := ioutil.WriteFile(caBundlePath, caBundle, 0644); err != nil {
+			panic(err)
+		}
+
+		/* act */
+		actualClient, actualErr := newClient(caBundlePath)
+
+		/* assert */
+		Expect(actualErr).To(BeNil())
+
+		res, err := actualClient.Get(server.URL)
+		Expect(err).To(BeNil())
+		res.Body.Close()
+	})
+	Context("CA bundle has no certs", func() {
+		It("should return expected error", func() {
+			/* arrange */
+			tmpDir, err := ioutil.TempDir("", "")
+			if err != nil {
+				panic(err)
+			}
+
+			caBundlePath := filepath.Join(tmpDir, "ca.pem")
+			if err := ioutil.WriteFile(caBundlePath, []byte("notACert"), 0644); err != nil {
+				panic(err)
+			}
+
+			/* act */
+			_, actualErr := newClient(caBundlePath)
+
+			/* assert */
+			Expect(actualErr).To(MatchError("invalid GIT_SSL_CAINFO: no certs found in '" + caBundlePath + "'"))
+		})
+	})
+})
@@ -0,0 +1,127 @@
+// Package redact exports a Redactor for redacting secrets from streams
+package redact
+
+import (
+	"bytes"
+	"encoding/base64"
+	"sort"
+)
+
+// Mask replaces redacted secrets
+const Mask = "***"
+
+// Redactor redacts secrets, & their base64 forms, from a stream of chunks.
+// Secrets split across chunks are handled by holding back the end of a chunk
+// while it could be the start of a secret.
+type Redactor struct {
+	// longest first so the longest match wins
+	secrets [][]byte
+	pending []byte
+}
+
+// New constructs a Redactor for secrets; empty secrets are ignored
+func New(
+	secrets []string,
+) *Redactor {
+	secretSet := map[string]bool{}
+	for _, secret := range secrets {
+		if secret == "" {
+			continue
+		}
+		secretSet[secret] = true
+		for _, encoding := range []*base64.Encoding{
+			base64.StdEncoding,
+			base64.URLEncoding,
+			// padding is sometimes stripped
+			base64.RawStdEncoding,
+			base64.RawURLEncoding,
+		} {
+			secretSet[encoding.EncodeToString([]byte(secret))] = true
+		}
+	}
+
+	redactor := &Redactor{}
+	for secret := range secretSet {
+		redactor.secrets = append(redactor.secrets, []byte(secret))
+	}
+	sort.Slice(redactor.secrets, func(i, j int) bool {
+		if len(redactor.secrets[i]) != len(redactor.secrets[j]) {
+			return len(redactor.secrets[i]) > len(redactor.secrets[j])
+		}
+		return bytes.Compare(redactor.secrets[i], redactor.secrets[j]) < 0
+	})
+
+	return redactor
+}
+
+// Redact returns chunk w/ secrets redacted, preceded by anything held back from prior chunks;
+// a trailing partial secret is held back until the next call to Redact or Flush
+func (r *Redactor) Redact(
+	chunk []byte,
+) []byte {
+	if len(r.secrets) == 0 {
+		return chunk
+	}
+
+	buffer := append(r.pending, chunk...)
+	r.pending = nil
+
+	redacted := make([]byte, 0, len(buffer))
+	for i := 0; i < len(buffer); {
+		if secret := r.matchAt(buffer[i:]); secret != nil {
+			redacted = append(redacted, Mask...)
+			i += len(secret)
+			continue
+		}
+
+		if r.isPartialSecret(buffer[i:]) {
+			// hold back; may be completed by the next chunk
+			r.pending = append([]byte{}, buffer[i:]...)
+			break
+		}
+
+		redacted = append(redacted, buffer[i])
+		i++
+	}
+
+	return redacted
+}
+
+// RedactString returns s w/ secrets redacted; nothing is held back
+func (r *Redactor) RedactString(
+	s string,
+) string {
+	redacted := r.Redact([]byte(s))
+	return string(append(redacted, r.Flush()...))
+}
+
+// Flush returns anything held back; it's not a secret since the stream ended before it was completed
+func (r *Redactor) Flush() []byte {
+	pending := r.pending
+	r.pending = nil
+	return pending
+}
+
+// matchAt returns the longest secret b starts with, or nil if none
+func (r *Redactor) matchAt(
+	b []byte,
+) []byte {
+	for _, secret := range r.secrets {
+		if bytes.HasPrefix(b, secret) {
+			return secret
+		}
+	}
+	return nil
+}
+
+// isPartialSecret tests if b is a proper prefix of any secret
+func (r *Redactor) isPartialSecret(
+	b []byte,
+) bool {
+	for _, secret := range r.secrets {
+		if len(b) < len(secret) && bytes.HasPrefix(secret, b) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,65 @@
+package redact
+
+import (
+	"encoding/base64"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Context("Redactor", func() {
+	Context("no secrets", func() {
+		It("should return chunk unchanged", func() {
+			/* arrange */
+			objectUnderTest := New([]string{""})
+
+			/* act */
+			actualResult := objectUnderTest.Redact([]byte("some log\n"))
+
+			/* assert */
+			Expect(string(actualResult)).To(Equal("some log\n"))
+		})
+	})
+	Context("chunk contains secret & its base64 form", func() {
+		It("should redact both", func() {
+			/* arrange */
+			objectUnderTest := New([]string{"hunter2"})
+
+			/* act */
+			actualResult := objectUnderTest.Redact(
+				[]byte("password hunter2 encoded " + base64.StdEncoding.EncodeToString([]byte("hunter2")) + "\n"),
+			)
+
+			/* assert */
+			Expect(string(actualResult)).To(Equal("password *** encoded ***\n"))
+		})
+	})
+	Context("secret split across chunks", func() {
+		It("should redact secret", func() {
+			/* arrange */
+			objectUnderTest := New([]string{"line1\nline2"})
+
+			/* act */
+			actualResult1 := objectUnderTest.Redact([]byte("key: line1\n"))
+			actualResult2 := objectUnderTest.Redact([]byte("line2\n"))
+
+			/* assert */
+			Expect(string(actualResult1)).To(Equal("key: "))
+			Expect(string(actualResult2)).To(Equal("***\n"))
+		})
+	})
+	Context("stream ends w/ partial secret", func() {
+		It("should return partial secret on flush", func() {
+			/* arrange */
+			objectUnderTest := New([]string{"hunter2"})
+
+			/* act */
+			actualResult := objectUnderTest.Redact([]byte("hunt"))
+			actualFlushResult := objectUnderTest.Flush()
+
+			/* assert */
+			Expect(string(actualResult)).To(Equal(""))
+			Expect(string(actualFlushResult)).To(Equal("hunt"))
+		})
+	})
+})
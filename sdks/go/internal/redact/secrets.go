@@ -0,0 +1,96 @@
+package redact
+
+import (
+	"encoding/json"
+	"sort"
+	"strconv"
+
+	"github.com/opctl/opctl/sdks/go/model"
+)
+
+// Secrets returns the values of inputs whose params are secret, in the forms they could appear in logs
+func Secrets(
+	params map[string]*model.Param,
+	inputs map[string]*model.Value,
+) []string {
+	names := []string{}
+	for name := range params {
+		names = append(names, name)
+	}
+	// deterministic order
+	sort.Strings(names)
+
+	var secrets []string
+	for _, name := range names {
+		if value, ok := inputs[name]; ok && value != nil && isSecret(params[name]) {
+			secrets = append(secrets, Values(*value)...)
+		}
+	}
+	return secrets
+}
+
+func isSecret(
+	param *model.Param,
+) bool {
+	if param == nil {
+		return false
+	}
+	switch {
+	case param.Array != nil:
+		return param.Array.IsSecret
+	case param.Number != nil:
+		return param.Number.IsSecret
+	case param.Object != nil:
+		return param.Object.IsSecret
+	case param.String != nil:
+		return param.String.IsSecret
+	}
+	// dirs, files, & sockets are passed by reference so their values needn't be redacted
+	return false
+}
+
+// Values returns the forms value could appear in logs
+func Values(
+	value model.Value,
+) []string {
+	switch {
+	case value.String != nil:
+		return []string{*value.String}
+	case value.Number != nil:
+		return []string{strconv.FormatFloat(*value.Number, 'f', -1, 64)}
+	case value.Array != nil, value.Object != nil:
+		nativeValue, err := value.Unbox()
+		if err != nil {
+			return nil
+		}
+		secrets := stringLeaves(nativeValue)
+		if valueBytes, err := json.Marshal(nativeValue); err == nil {
+			secrets = append(secrets, string(valueBytes))
+		}
+		return secrets
+	}
+	return nil
+}
+
+// stringLeaves returns the string leaves of an unboxed array or object
+func stringLeaves(
+	nativeValue interface{},
+) []string {
+	switch typedValue := nativeValue.(type) {
+	case string:
+		return []string{typedValue}
+	case []interface{}:
+		leaves := []string{}
+		for _, item := range typedValue {
+			leaves = append(leaves, stringLeaves(item)...)
+		}
+		return leaves
+	case map[string]interface{}:
+		leaves := []string{}
+		for _, property := range typedValue {
+			leaves = append(leaves, stringLeaves(property)...)
+		}
+		return leaves
+	}
+	return nil
+}
@@ -0,0 +1,36 @@
+package redact
+
+import (
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/opctl/opctl/sdks/go/model"
+)
+
+var _ = Context("Secrets", func() {
+	It("should return values of secret inputs", func() {
+		/* arrange */
+		password := "hunter2"
+		username := "user"
+		pin := 1234.0
+		creds := map[string]interface{}{"token": "abc"}
+
+		/* act */
+		actualSecrets := Secrets(
+			map[string]*model.Param{
+				"creds":    {Object: &model.ObjectParam{IsSecret: true}},
+				"password": {String: &model.StringParam{IsSecret: true}},
+				"pin":      {Number: &model.NumberParam{IsSecret: true}},
+				"username": {String: &model.StringParam{}},
+			},
+			map[string]*model.Value{
+				"creds":    {Object: &creds},
+				"password": {String: &password},
+				"pin":      {Number: &pin},
+				"username": {String: &username},
+			},
+		)
+
+		/* assert */
+		Expect(actualSecrets).To(Equal([]string{"abc", `{"token":"abc"}`, "hunter2", "1234"}))
+	})
+})
@@ -0,0 +1,13 @@
+package targz
+
+import (
+	"testing"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+func TestTargz(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "internal/targz")
+}
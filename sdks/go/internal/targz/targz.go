@@ -0,0 +1,158 @@
+// Package targz writes & extracts dirs as gzipped tars
+package targz
+
+import (
+	"archive/tar"
+	"compress/gzip"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/opencontainers/go-digest"
+)
+
+// Write writes the dir at 'path' to 'writer' as a gzipped tar.
+//
+// The digest of the uncompressed tar is returned.
+//
+// Archives are reproducible; entries are written in lexical order w/out owners or timestamps.
+// '.git' dirs are excluded; only dirs & regular files are supported.
+func Write(
+	path string,
+	writer io.Writer,
+) (digest.Digest, error) {
+	gzipWriter := gzip.NewWriter(writer)
+	digester := digest.Canonical.Digester()
+	tarWriter := tar.NewWriter(io.MultiWriter(gzipWriter, digester.Hash()))
+
+	err := filepath.Walk(
+		path,
+		func(contentPath string, fileInfo os.FileInfo, err error) error {
+			if err != nil {
+				return err
+			}
+
+			if contentPath == path {
+				return nil
+			}
+
+			if fileInfo.IsDir() && fileInfo.Name() == ".git" {
+				return filepath.SkipDir
+			}
+
+			if !fileInfo.IsDir() && !fileInfo.Mode().IsRegular() {
+				return fmt.Errorf("unable to archive '%v'; only dirs & regular files are supported", contentPath)
+			}
+
+			relPath, err := filepath.Rel(path, contentPath)
+			if err != nil {
+				return err
+			}
+
+			header, err := tar.FileInfoHeader(fileInfo, "")
+			if err != nil {
+				return err
+			}
+			header.Name = filepath.ToSlash(relPath)
+			header.ModTime = time.Unix(0, 0)
+			header.Uid, header.Gid, header.Uname, header.Gname = 0, 0, "", ""
+
+			if err := tarWriter.WriteHeader(header); err != nil {
+				return err
+			}
+
+			if fileInfo.IsDir() {
+				return nil
+			}
+
+			file, err := os.Open(contentPath)
+			if err != nil {
+				return err
+			}
+			defer file.Close()
+
+			_, err = io.Copy(tarWriter, file)
+			return err
+		},
+	)
+	if err != nil {
+		return "", err
+	}
+
+	if err := tarWriter.Close(); err != nil {
+		return "", err
+	}
+
+	return digester.Digest(), gzipWriter.Close()
+}
+
+// Extract extracts the gzipped tar read from 'reader' to 'path'.
+//
+// Only dirs & regular files are supported; pax global headers (i.e. of git archives) are ignored.
+func Extract(
+	reader io.Reader,
+	path string,
+) error {
+	gzipReader, err := gzip.NewReader(reader)
+	if err != nil {
+		return err
+	}
+	defer gzipReader.Close()
+
+	if err := os.MkdirAll(path, 0777); err != nil {
+		return err
+	}
+
+	tarReader := tar.NewReader(gzipReader)
+	for {
+		header, err := tarReader.Next()
+		if err == io.EOF {
+			return nil
+		} else if err != nil {
+			return err
+		}
+
+		// guard against entries escaping path
+		contentPath := filepath.Join(path, filepath.FromSlash(header.Name))
+		if contentPath != path && !strings.HasPrefix(contentPath, path+string(os.PathSeparator)) {
+			return fmt.Errorf("invalid archive entry '%v'", header.Name)
+		}
+
+		switch header.Typeflag {
+		case tar.TypeXGlobalHeader:
+			continue
+		case tar.TypeDir:
+			if err := os.MkdirAll(contentPath, 0777); err != nil {
+				return err
+			}
+		case tar.TypeReg, tar.TypeRegA:
+			if err := extractFile(tarReader, contentPath, header.FileInfo().Mode()); err != nil {
+				return err
+			}
+		default:
+			return fmt.Errorf("invalid archive entry '%v'; only dirs & regular files are supported", header.Name)
+		}
+	}
+}
+
+func extractFile(
+	reader io.Reader,
+	path string,
+	mode os.FileMode,
+) error {
+	if err := os.MkdirAll(filepath.Dir(path), 0777); err != nil {
+		return err
+	}
+
+	file, err := os.OpenFile(path, os.O_CREATE|os.O_TRUNC|os.O_WRONLY, mode.Perm())
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+
+	_, err = io.Copy(file, reader)
+	return err
+}
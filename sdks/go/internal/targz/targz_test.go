@@ -0,0 +1,158 @@
+package targz
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+// newTestDir creates a dir containing an op.yml w/ 'opFile' & returns its path
+func newTestDir(
+	opFile string,
+) string {
+	dirPath, err := ioutil.TempDir("", "")
+	if err != nil {
+		panic(err)
+	}
+
+	if err := ioutil.WriteFile(filepath.Join(dirPath, "op.yml"), []byte(opFile), 0644); err != nil {
+		panic(err)
+	}
+
+	if err := os.MkdirAll(filepath.Join(dirPath, "sub", ".git"), 0777); err != nil {
+		panic(err)
+	}
+
+	if err := ioutil.WriteFile(filepath.Join(dirPath, "sub", "script.sh"), []byte("echo hi"), 0755); err != nil {
+		panic(err)
+	}
+
+	return dirPath
+}
+
+var _ = Context("targz", func() {
+	Context("Write then Extract", func() {
+		It("should round trip the dir excluding '.git' dirs", func() {
+			/* arrange */
+			dirPath := newTestDir("name: test")
+			archive := &bytes.Buffer{}
+
+			extractPath, err := ioutil.TempDir("", "")
+			if err != nil {
+				panic(err)
+			}
+
+			/* act */
+			_, actualWriteErr := Write(dirPath, archive)
+			actualExtractErr := Extract(archive, extractPath)
+
+			/* assert */
+			Expect(actualWriteErr).To(BeNil())
+			Expect(actualExtractErr).To(BeNil())
+
+			actualOpFile, err := ioutil.ReadFile(filepath.Join(extractPath, "op.yml"))
+			Expect(err).To(BeNil())
+			Expect(string(actualOpFile)).To(Equal("name: test"))
+
+			scriptInfo, err := os.Stat(filepath.Join(extractPath, "sub", "script.sh"))
+			Expect(err).To(BeNil())
+			Expect(scriptInfo.Mode().Perm()).To(Equal(os.FileMode(0755)))
+
+			_, err = os.Stat(filepath.Join(extractPath, "sub", ".git"))
+			Expect(os.IsNotExist(err)).To(BeTrue())
+		})
+	})
+	Context("Write", func() {
+		It("should be reproducible", func() {
+			/* arrange */
+			dirPath := newTestDir("name: test")
+			firstArchive := &bytes.Buffer{}
+			secondArchive := &bytes.Buffer{}
+
+			if _, err := Write(dirPath, firstArchive); err != nil {
+				panic(err)
+			}
+
+			// modify mtime
+			if err := ioutil.WriteFile(filepath.Join(dirPath, "op.yml"), []byte("name: test"), 0644); err != nil {
+				panic(err)
+			}
+
+			/* act */
+			_, actualErr := Write(dirPath, secondArchive)
+
+			/* assert */
+			Expect(actualErr).To(BeNil())
+			Expect(secondArchive.Bytes()).To(Equal(firstArchive.Bytes()))
+		})
+	})
+	Context("Extract", func() {
+		Context("archive of '.' w/ pax global header", func() {
+			It("should extract entries", func() {
+				/* arrange */
+				archive := &bytes.Buffer{}
+				gzipWriter := gzip.NewWriter(archive)
+				tarWriter := tar.NewWriter(gzipWriter)
+				if err := tarWriter.WriteHeader(&tar.Header{Name: "pax_global_header", Typeflag: tar.TypeXGlobalHeader, PAXRecords: map[string]string{"comment": "abc"}}); err != nil {
+					panic(err)
+				}
+				if err := tarWriter.WriteHeader(&tar.Header{Name: "./", Typeflag: tar.TypeDir, Mode: 0755}); err != nil {
+					panic(err)
+				}
+				if err := tarWriter.WriteHeader(&tar.Header{Name: "./op.yml", Typeflag: tar.TypeReg, Mode: 0644, Size: 10}); err != nil {
+					panic(err)
+				}
+				if _, err := tarWriter.Write([]byte("name: test")); err != nil {
+					panic(err)
+				}
+				tarWriter.Close()
+				gzipWriter.Close()
+
+				extractPath, err := ioutil.TempDir("", "")
+				if err != nil {
+					panic(err)
+				}
+
+				/* act */
+				actualErr := Extract(archive, extractPath)
+
+				/* assert */
+				Expect(actualErr).To(BeNil())
+
+				actualOpFile, err := ioutil.ReadFile(filepath.Join(extractPath, "op.yml"))
+				Expect(err).To(BeNil())
+				Expect(string(actualOpFile)).To(Equal("name: test"))
+			})
+		})
+		Context("entry escapes path", func() {
+			It("should return expected error", func() {
+				/* arrange */
+				archive := &bytes.Buffer{}
+				gzipWriter := gzip.NewWriter(archive)
+				tarWriter := tar.NewWriter(gzipWriter)
+				if err := tarWriter.WriteHeader(&tar.Header{Name: "../escaped", Typeflag: tar.TypeReg, Mode: 0644}); err != nil {
+					panic(err)
+				}
+				tarWriter.Close()
+				gzipWriter.Close()
+
+				extractPath, err := ioutil.TempDir("", "")
+				if err != nil {
+					panic(err)
+				}
+
+				/* act */
+				actualErr := Extract(archive, extractPath)
+
+				/* assert */
+				Expect(actualErr).To(MatchError("invalid archive entry '../escaped'"))
+			})
+		})
+	})
+})
@@ -1,5 +1,7 @@
 package model
 
+import "time"
+
 //Auth holds auth data
 type Auth struct {
 	// Resources designates which resources this auth applies to in the form of a reference (or prefix thereof)
@@ -10,9 +12,14 @@ type Auth struct {
 //Call is a node of a call graph; see https://en.wikipedia.org/wiki/Call_graph
 type Call struct {
 	Container *ContainerCall `json:"container,omitempty"`
+	Dag       []*CallSpec    `json:"dag,omitempty"`
+	// called instead of the call because If is false
+	Else *CallSpec `json:"else,omitempty"`
 	// id of call
-	ID           string            `json:"id"`
-	If           *bool             `json:"if,omitempty"`
+	ID string `json:"id"`
+	If *bool  `json:"if,omitempty"`
+	// IsFinally is true for finally calls; they aren't killed along w/ their parent, only when killed by their own ID
+	IsFinally    bool              `json:"isFinally,omitempty"`
 	IsKilled     bool              `json:"isKilled"`
 	Name         *string           `json:"name,omitempty"`
 	Needs        []string          `json:"needs,omitempty"`
@@ -25,6 +32,7 @@ type Call struct {
 	RootID     string          `json:"rootId"`
 	Serial     []*CallSpec     `json:"serial,omitempty"`
 	SerialLoop *SerialLoopCall `json:"serialLoop,omitempty"`
+	Switch     *SwitchCall     `json:"switch,omitempty"`
 }
 
 type BaseCall struct {
@@ -73,14 +81,22 @@ type LoopVars struct {
 //OpCall is a call of an op
 type OpCall struct {
 	BaseCall
+	// Commit is the SHA of the commit the op was sourced from; empty unless sourced from git
+	Commit string `json:"commit,omitempty"`
+	// Ref is the ref the op was resolved from w/ any version range replaced by the version it resolved to
+	Ref               string            `json:"ref,omitempty"`
 	OpID              string            `json:"opId"`
 	Inputs            map[string]*Value `json:"inputs"`
 	ChildCallCallSpec *CallSpec         `json:"childCallScg"`
 	ChildCallID       string            `json:"childCallId"`
+	// values of inputs whose params are secret; never serialized so they don't end up in events
+	Secrets []string `json:"-"`
 }
 
 //ParallelLoopCall is a call of a parallel loop
 type ParallelLoopCall struct {
+	// max number of iterations in flight; nil if unlimited
+	MaxConcurrency *int `json:"maxConcurrency,omitempty"`
 	// an array or object
 	Range *Value    `json:"range,omitempty"`
 	Run   Call      `json:"run,omitempty"`
@@ -95,9 +111,21 @@ type Predicate struct {
 
 //SerialLoopCall is a call of a serial loop
 type SerialLoopCall struct {
+	// delay between iterations; nil if none
+	Delay *time.Duration `json:"delay,omitempty"`
+	// max number of iterations; nil if unlimited
+	MaxIterations *int `json:"maxIterations,omitempty"`
 	// an array or object
 	Range *Value    `json:"range,omitempty"`
 	Run   Call      `json:"run,omitempty"`
 	Until *bool     `json:"until,omitempty"`
 	Vars  *LoopVars `json:"vars,omitempty"`
 }
+
+//SwitchCall is a call of a switch
+type SwitchCall struct {
+	// index of the matched case; nil if no case matched
+	Case *int `json:"case,omitempty"`
+	// the matched case's call or the default; nil if neither
+	Run *CallSpec `json:"run,omitempty"`
+}
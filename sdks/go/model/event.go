@@ -5,6 +5,7 @@ import "time"
 // Event represents a distributed state change
 type Event struct {
 	AuthAdded                *AuthAdded                `json:"authAdded,omitempty"`
+	AuthRemoved              *AuthRemoved              `json:"authRemoved,omitempty"`
 	CallEnded                *CallEnded                `json:"callEnded,omitempty"`
 	CallStarted              *CallStarted              `json:"callStarted,omitempty"`
 	ContainerStdErrWrittenTo *ContainerStdErrWrittenTo `json:"containerStdErrWrittenTo,omitempty"`
@@ -19,11 +20,16 @@ const (
 	OpOutcomeKilled    = "KILLED"
 )
 
-// AuthAdded represents auth was added for external resources
+// AuthAdded represents auth was added for external resources; Auth.Password is omitted
 type AuthAdded struct {
 	Auth Auth `json:"auth"`
 }
 
+// AuthRemoved represents auth was removed for external resources
+type AuthRemoved struct {
+	Resources string `json:"resources"`
+}
+
 // CallKillRequested represents a request was made to kill an op; a CallEnded event may follow
 type CallKillRequested struct {
 	Request KillOpReq `json:"request"`
@@ -31,11 +37,13 @@ type CallKillRequested struct {
 
 // CallEnded represents a call ended; no further events will occur for the call
 type CallEnded struct {
-	Call    Call              `json:"call"`
-	Ref     string            `json:"ref"`
-	Error   *CallEndedError   `json:"error,omitempty"`
-	Outputs map[string]*Value `json:"outputs"`
-	Outcome string            `json:"outcome"`
+	Call  Call            `json:"call"`
+	Ref   string          `json:"ref"`
+	Error *CallEndedError `json:"error,omitempty"`
+	// IgnoredError is the error of a call w/ continueOnError; such calls have a SUCCEEDED outcome
+	IgnoredError *CallEndedError   `json:"ignoredError,omitempty"`
+	Outputs      map[string]*Value `json:"outputs"`
+	Outcome      string            `json:"outcome"`
 }
 
 // CallStarted represents the start of an op
@@ -2,18 +2,29 @@ package model
 
 // OpSpec is a spec for an op
 type OpSpec struct {
-	Description string            `json:"description"`
-	Inputs      map[string]*Param `json:"inputs,omitempty"`
-	Name        string            `json:"name"`
-	Outputs     map[string]*Param `json:"outputs,omitempty"`
-	Run         *CallSpec         `json:"run,omitempty"`
-	Version     string            `json:"version,omitempty"`
+	Description string `json:"description"`
+	// Finally is called after run regardless of outcome, even if the op is killed
+	Finally *CallSpec         `json:"finally,omitempty"`
+	Inputs  map[string]*Param `json:"inputs,omitempty"`
+	Name    string            `json:"name"`
+	Outputs map[string]*Param `json:"outputs,omitempty"`
+	Run     *CallSpec         `json:"run,omitempty"`
+	Version string            `json:"version,omitempty"`
 }
 
 //CallSpec is a spec for a node of a call graph; see https://en.wikipedia.org/wiki/Call_graph
 type CallSpec struct {
-	Container    *ContainerCallSpec    `json:"container,omitempty"`
-	Description  string                `json:"description,omitempty"`
+	Container *ContainerCallSpec `json:"container,omitempty"`
+	// ContinueOnError records failure of the call but reports success to its parent
+	ContinueOnError bool         `json:"continueOnError,omitempty"`
+	Dag             *[]*CallSpec `json:"dag,omitempty"`
+	Description     string       `json:"description,omitempty"`
+	// Else is called instead of the call if If evaluates to false
+	Else *CallSpec `json:"else,omitempty"`
+	// FailFast false lets all children of a parallel call finish when one fails; defaults to true
+	FailFast *bool `json:"failFast,omitempty"`
+	// Finally is called after the children of a serial call regardless of outcome, even if the call is killed
+	Finally      *CallSpec             `json:"finally,omitempty"`
 	If           *[]*PredicateSpec     `json:"if,omitempty"`
 	Name         *string               `json:"name,omitempty"`
 	Needs        []string              `json:"needs,omitempty"`
@@ -22,6 +33,7 @@ type CallSpec struct {
 	ParallelLoop *ParallelLoopCallSpec `json:"parallelLoop,omitempty"`
 	Serial       *[]*CallSpec          `json:"serial,omitempty"`
 	SerialLoop   *SerialLoopCallSpec   `json:"serialLoop,omitempty"`
+	Switch       *SwitchCallSpec       `json:"switch,omitempty"`
 }
 
 //ContainerCallSpec is a spec for calling a container
@@ -69,9 +81,17 @@ type OpCallSpec struct {
 
 //ParallelLoopCallSpec is a spec for calling a parallel loop
 type ParallelLoopCallSpec struct {
-	Range interface{}   `json:"range,omitempty"`
-	Run   CallSpec      `json:"run,omitempty"`
-	Vars  *LoopVarsSpec `json:"vars,omitempty"`
+	// Collect names outputs to gather from every iteration
+	Collect []string `json:"collect,omitempty"`
+	// FailFast false lets all iterations finish when one fails; defaults to true
+	FailFast *bool `json:"failFast,omitempty"`
+	// Matrix ranges over the combinations of an object of arrays (in place of Range); will be interpreted to an object
+	Matrix interface{} `json:"matrix,omitempty"`
+	// MaxConcurrency limits the number of iterations in flight; will be interpreted to a number
+	MaxConcurrency interface{}   `json:"maxConcurrency,omitempty"`
+	Range          interface{}   `json:"range,omitempty"`
+	Run            CallSpec      `json:"run,omitempty"`
+	Vars           *LoopVarsSpec `json:"vars,omitempty"`
 }
 
 //PredicateSpec is a spec for a predicate
@@ -92,10 +112,32 @@ type CredsSpec struct {
 
 //SerialLoopCallSpec is a spec for calling a serial loop
 type SerialLoopCallSpec struct {
-	Range interface{}      `json:"range,omitempty"`
-	Run   CallSpec         `json:"run,omitempty"`
-	Until []*PredicateSpec `json:"until,omitempty"`
-	Vars  *LoopVarsSpec    `json:"vars,omitempty"`
+	// Collect names outputs to gather from every iteration
+	Collect []string `json:"collect,omitempty"`
+	// Delay between iterations; will be interpreted to a duration string such as "5s"
+	Delay interface{} `json:"delay,omitempty"`
+	// Matrix ranges over the combinations of an object of arrays (in place of Range); will be interpreted to an object
+	Matrix interface{} `json:"matrix,omitempty"`
+	// MaxIterations fails the loop if it's not done after this many iterations; will be interpreted to a number
+	MaxIterations interface{}      `json:"maxIterations,omitempty"`
+	Range         interface{}      `json:"range,omitempty"`
+	Run           CallSpec         `json:"run,omitempty"`
+	Until         []*PredicateSpec `json:"until,omitempty"`
+	Vars          *LoopVarsSpec    `json:"vars,omitempty"`
+}
+
+//SwitchCallSpec is a spec for calling the first case whose predicates are all true
+type SwitchCallSpec struct {
+	// Cases are evaluated in order
+	Cases []*SwitchCaseSpec `json:"cases,omitempty"`
+	// Default is called if no case matches
+	Default *CallSpec `json:"default,omitempty"`
+}
+
+//SwitchCaseSpec is a spec for a case of a switch call
+type SwitchCaseSpec struct {
+	If  []*PredicateSpec `json:"if"`
+	Run CallSpec         `json:"run"`
 }
 
 type ReferenceOpts struct {
@@ -11,6 +11,12 @@ type AddAuthReq struct {
 	Creds
 }
 
+// RemoveAuthReq holds data for removing source credentials
+type RemoveAuthReq struct {
+	// Resources designates which auth to remove; must equal the Resources the auth was added w/
+	Resources string
+}
+
 type EventFilter struct {
 	// filter to events from these root op id's
 	Roots []string
@@ -0,0 +1,58 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io/ioutil"
+	"net/http"
+	"path"
+
+	"github.com/opctl/opctl/sdks/go/model"
+	"github.com/opctl/opctl/sdks/go/node/api"
+)
+
+func (c apiClient) ListAuths(
+	ctx context.Context,
+) (
+	[]model.Auth,
+	error,
+) {
+
+	reqURL := c.baseURL
+	reqURL.Path = path.Join(reqURL.Path, api.URLAuths_Lists)
+
+	httpReq, err := http.NewRequestWithContext(
+		ctx,
+		"GET",
+		reqURL.String(),
+		nil,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	httpResp, err := c.httpClient.Do(httpReq)
+	if err != nil {
+		return nil, err
+	}
+	// don't leak resources
+	defer httpResp.Body.Close()
+
+	bodyBytes, err := ioutil.ReadAll(httpResp.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	if http.StatusOK != httpResp.StatusCode {
+		return nil, errors.New(string(bodyBytes))
+	}
+
+	auths := []model.Auth{}
+	if err := json.Unmarshal(bodyBytes, &auths); err != nil {
+		return nil, err
+	}
+
+	return auths, nil
+
+}
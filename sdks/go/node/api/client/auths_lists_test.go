@@ -0,0 +1,85 @@
+package client
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+
+	"github.com/golang-interfaces/ihttp"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/opctl/opctl/sdks/go/model"
+	"github.com/opctl/opctl/sdks/go/node/api"
+)
+
+var _ = Context("ListAuths", func() {
+
+	It("should call httpClient.Do() with expected args", func() {
+
+		/* arrange */
+		providedCtx := context.TODO()
+
+		expectedReqURL := url.URL{}
+		expectedReqURL.Path = api.URLAuths_Lists
+
+		fakeHttpClient := new(ihttp.FakeClient)
+		fakeHttpClient.DoReturns(&http.Response{Body: ioutil.NopCloser(bytes.NewReader([]byte{}))}, nil)
+
+		objectUnderTest := apiClient{
+			httpClient: fakeHttpClient,
+		}
+
+		/* act */
+		objectUnderTest.ListAuths(providedCtx)
+
+		/* assert */
+		actualHTTPReq := fakeHttpClient.DoArgsForCall(0)
+
+		Expect(actualHTTPReq.URL.String()).To(Equal(expectedReqURL.String()))
+		Expect(actualHTTPReq.Method).To(Equal("GET"))
+		Expect(actualHTTPReq.Context()).To(Equal(providedCtx))
+
+	})
+
+	It("should return expected result", func() {
+
+		/* arrange */
+		expectedAuths := []model.Auth{
+			{
+				Resources: "docker.io",
+				Creds: model.Creds{
+					Username: "username",
+				},
+			},
+		}
+
+		respBytes, err := json.Marshal(expectedAuths)
+		if err != nil {
+			panic(err)
+		}
+
+		fakeHttpClient := new(ihttp.FakeClient)
+		fakeHttpClient.DoReturns(
+			&http.Response{
+				Body:       ioutil.NopCloser(bytes.NewReader(respBytes)),
+				StatusCode: http.StatusOK,
+			},
+			nil,
+		)
+
+		objectUnderTest := apiClient{
+			httpClient: fakeHttpClient,
+		}
+
+		/* act */
+		actualAuths, actualErr := objectUnderTest.ListAuths(context.TODO())
+
+		/* assert */
+		Expect(actualErr).To(BeNil())
+		Expect(actualAuths).To(Equal(expectedAuths))
+
+	})
+})
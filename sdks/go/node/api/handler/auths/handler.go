@@ -8,6 +8,8 @@ import (
 	"github.com/opctl/opctl/sdks/go/internal/urlpath"
 	"github.com/opctl/opctl/sdks/go/node"
 	"github.com/opctl/opctl/sdks/go/node/api/handler/auths/adds"
+	"github.com/opctl/opctl/sdks/go/node/api/handler/auths/lists"
+	"github.com/opctl/opctl/sdks/go/node/api/handler/auths/removals"
 )
 
 //counterfeiter:generate -o fakes/handler.go . Handler
@@ -23,12 +25,16 @@ func NewHandler(
 	node node.Node,
 ) Handler {
 	return _handler{
-		addsHandler: adds.NewHandler(node),
+		addsHandler:     adds.NewHandler(node),
+		listsHandler:    lists.NewHandler(node),
+		removalsHandler: removals.NewHandler(node),
 	}
 }
 
 type _handler struct {
-	addsHandler adds.Handler
+	addsHandler     adds.Handler
+	listsHandler    lists.Handler
+	removalsHandler removals.Handler
 }
 
 func (hdlr _handler) Handle(
@@ -47,6 +53,16 @@ func (hdlr _handler) Handle(
 			httpResp,
 			httpReq,
 		)
+	case "lists":
+		hdlr.listsHandler.Handle(
+			httpResp,
+			httpReq,
+		)
+	case "removals":
+		hdlr.removalsHandler.Handle(
+			httpResp,
+			httpReq,
+		)
 	default:
 		http.NotFoundHandler().ServeHTTP(httpResp, httpReq)
 		return
@@ -6,6 +6,8 @@ import (
 	"strings"
 
 	addsFakes "github.com/opctl/opctl/sdks/go/node/api/handler/auths/adds/fakes"
+	listsFakes "github.com/opctl/opctl/sdks/go/node/api/handler/auths/lists/fakes"
+	removalsFakes "github.com/opctl/opctl/sdks/go/node/api/handler/auths/removals/fakes"
 	nodeFakes "github.com/opctl/opctl/sdks/go/node/fakes"
 
 	. "github.com/onsi/ginkgo"
@@ -63,6 +65,64 @@ var _ = Context("Handler", func() {
 
 				Expect(actualHTTPReq.URL.Path).To(Equal(expectedURLPath))
 
+				// this works because our URL path set mutates the httpRequest
+				Expect(actualHTTPReq).To(Equal(providedHTTPReq))
+			})
+		})
+		Context("next URL path segment is lists", func() {
+			It("should call refHandler.Handle w/ expected args", func() {
+				/* arrange */
+				fakeListsHandler := new(listsFakes.FakeHandler)
+
+				objectUnderTest := _handler{
+					listsHandler: fakeListsHandler,
+				}
+
+				providedPath := "lists/dummy"
+				providedHTTPReq, err := http.NewRequest("dummyMethod", providedPath, nil)
+				if err != nil {
+					panic(err.Error())
+				}
+
+				expectedURLPath := strings.SplitN(providedPath, "/", 2)[1]
+
+				/* act */
+				objectUnderTest.Handle(httptest.NewRecorder(), providedHTTPReq)
+
+				/* assert */
+				_, actualHTTPReq := fakeListsHandler.HandleArgsForCall(0)
+
+				Expect(actualHTTPReq.URL.Path).To(Equal(expectedURLPath))
+
+				// this works because our URL path set mutates the httpRequest
+				Expect(actualHTTPReq).To(Equal(providedHTTPReq))
+			})
+		})
+		Context("next URL path segment is removals", func() {
+			It("should call refHandler.Handle w/ expected args", func() {
+				/* arrange */
+				fakeRemovalsHandler := new(removalsFakes.FakeHandler)
+
+				objectUnderTest := _handler{
+					removalsHandler: fakeRemovalsHandler,
+				}
+
+				providedPath := "removals/dummy"
+				providedHTTPReq, err := http.NewRequest("dummyMethod", providedPath, nil)
+				if err != nil {
+					panic(err.Error())
+				}
+
+				expectedURLPath := strings.SplitN(providedPath, "/", 2)[1]
+
+				/* act */
+				objectUnderTest.Handle(httptest.NewRecorder(), providedHTTPReq)
+
+				/* assert */
+				_, actualHTTPReq := fakeRemovalsHandler.HandleArgsForCall(0)
+
+				Expect(actualHTTPReq.URL.Path).To(Equal(expectedURLPath))
+
 				// this works because our URL path set mutates the httpRequest
 				Expect(actualHTTPReq).To(Equal(providedHTTPReq))
 			})
@@ -0,0 +1,2 @@
+// Package lists exposes functionality for handling "auths/lists" requests.
+package lists
@@ -0,0 +1,84 @@
+package lists
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/opctl/opctl/sdks/go/model"
+	"github.com/opctl/opctl/sdks/go/node/api"
+	nodeFakes "github.com/opctl/opctl/sdks/go/node/fakes"
+)
+
+var _ = Context("Handler", func() {
+	Context("NewHandler", func() {
+		It("should not return nil", func() {
+			/* arrange/act/assert */
+			Expect(NewHandler(new(nodeFakes.FakeNode))).Should(Not(BeNil()))
+		})
+	})
+	Context("Handle", func() {
+		Context("node.ListAuths errors", func() {
+			It("should return StatusCode of 500", func() {
+				/* arrange */
+				fakeNode := new(nodeFakes.FakeNode)
+				fakeNode.ListAuthsReturns(nil, errors.New("dummyError"))
+
+				objectUnderTest := _handler{
+					node: fakeNode,
+				}
+				providedHTTPResp := httptest.NewRecorder()
+
+				providedHTTPReq, err := http.NewRequest(http.MethodGet, api.URLAuths_Lists, nil)
+				if err != nil {
+					panic(err.Error())
+				}
+
+				/* act */
+				objectUnderTest.Handle(providedHTTPResp, providedHTTPReq)
+
+				/* assert */
+				Expect(providedHTTPResp.Code).To(Equal(http.StatusInternalServerError))
+			})
+		})
+		Context("node.ListAuths doesn't error", func() {
+			It("should return expected result", func() {
+				/* arrange */
+				expectedAuths := []model.Auth{
+					{
+						Resources: "docker.io",
+						Creds: model.Creds{
+							Username: "username",
+						},
+					},
+				}
+
+				fakeNode := new(nodeFakes.FakeNode)
+				fakeNode.ListAuthsReturns(expectedAuths, nil)
+
+				objectUnderTest := _handler{
+					node: fakeNode,
+				}
+				providedHTTPResp := httptest.NewRecorder()
+
+				providedHTTPReq, err := http.NewRequest(http.MethodGet, api.URLAuths_Lists, nil)
+				if err != nil {
+					panic(err.Error())
+				}
+
+				/* act */
+				objectUnderTest.Handle(providedHTTPResp, providedHTTPReq)
+
+				/* assert */
+				Expect(providedHTTPResp.Code).To(Equal(http.StatusOK))
+
+				actualAuths := []model.Auth{}
+				Expect(json.NewDecoder(providedHTTPResp.Body).Decode(&actualAuths)).To(BeNil())
+				Expect(actualAuths).To(Equal(expectedAuths))
+			})
+		})
+	})
+})
@@ -0,0 +1,2 @@
+// Package removals exposes functionality for handling "auths/removals" requests.
+package removals
@@ -0,0 +1,76 @@
+package removals
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/opctl/opctl/sdks/go/model"
+	"github.com/opctl/opctl/sdks/go/node/api"
+	nodeFakes "github.com/opctl/opctl/sdks/go/node/fakes"
+)
+
+var _ = Context("Handler", func() {
+	Context("NewHandler", func() {
+		It("should not return nil", func() {
+			/* arrange/act/assert */
+			Expect(NewHandler(new(nodeFakes.FakeNode))).Should(Not(BeNil()))
+		})
+	})
+	Context("Handle", func() {
+		Context("json.Decoder.Decode errors", func() {
+			It("should return StatusCode of 400", func() {
+				/* arrange */
+				objectUnderTest := _handler{
+					node: new(nodeFakes.FakeNode),
+				}
+				providedHTTPResp := httptest.NewRecorder()
+
+				providedHTTPReq, err := http.NewRequest(http.MethodPost, api.URLAuths_Removals, bytes.NewReader([]byte{}))
+				if err != nil {
+					panic(err.Error())
+				}
+
+				/* act */
+				objectUnderTest.Handle(providedHTTPResp, providedHTTPReq)
+
+				/* assert */
+				Expect(providedHTTPResp.Code).To(Equal(http.StatusBadRequest))
+			})
+		})
+		Context("node.RemoveAuth errors", func() {
+			It("should return StatusCode of 404", func() {
+				/* arrange */
+				fakeNode := new(nodeFakes.FakeNode)
+				fakeNode.RemoveAuthReturns(errors.New("dummyError"))
+
+				objectUnderTest := _handler{
+					node: fakeNode,
+				}
+				providedHTTPResp := httptest.NewRecorder()
+
+				reqBytes, err := json.Marshal(model.RemoveAuthReq{Resources: "docker.io"})
+				if err != nil {
+					panic(err.Error())
+				}
+
+				providedHTTPReq, err := http.NewRequest(http.MethodPost, api.URLAuths_Removals, bytes.NewReader(reqBytes))
+				if err != nil {
+					panic(err.Error())
+				}
+
+				/* act */
+				objectUnderTest.Handle(providedHTTPResp, providedHTTPReq)
+
+				/* assert */
+				Expect(providedHTTPResp.Code).To(Equal(http.StatusNotFound))
+				_, actualReq := fakeNode.RemoveAuthArgsForCall(0)
+				Expect(actualReq).To(Equal(model.RemoveAuthReq{Resources: "docker.io"}))
+			})
+		})
+	})
+})
@@ -3,6 +3,8 @@ package api
 /* resources */
 const (
 	URLAuths_Adds             string = "/auths/adds"
+	URLAuths_Lists            string = "/auths/lists"
+	URLAuths_Removals         string = "/auths/removals"
 	URLEvents_Stream          string = "/events/stream"
 	URLLiveness               string = "/liveness"
 	URLOps_Kills              string = "/ops/kills"
@@ -11,11 +11,23 @@ func (this core) AddAuth(
 	ctx context.Context,
 	req model.AddAuthReq,
 ) error {
+	if err := this.stateStore.AddAuth(
+		model.Auth{
+			Creds:     req.Creds,
+			Resources: req.Resources,
+		},
+	); err != nil {
+		return err
+	}
+
 	this.pubSub.Publish(
 		model.Event{
 			AuthAdded: &model.AuthAdded{
 				Auth: model.Auth{
-					Creds:     req.Creds,
+					// omit password; events are stored & streamed in plaintext
+					Creds: model.Creds{
+						Username: req.Creds.Username,
+					},
 					Resources: req.Resources,
 				},
 			},
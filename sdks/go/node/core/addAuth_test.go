@@ -1,6 +1,7 @@
 package core
 
 import (
+	"bytes"
 	"context"
 	"io/ioutil"
 	"time"
@@ -10,12 +11,13 @@ import (
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
 	"github.com/opctl/opctl/sdks/go/model"
+	"github.com/opctl/opctl/sdks/go/node/core/nodekey"
 	"github.com/opctl/opctl/sdks/go/pubsub"
 )
 
 var _ = Context("core", func() {
 	Context("AddAuth", func() {
-		It("should call opAdder.Add w/ expected args", func() {
+		It("should store auth & publish AuthAdded w/out password", func() {
 
 			/* arrange */
 			providedReq := model.AddAuthReq{
@@ -38,6 +40,11 @@ var _ = Context("core", func() {
 				panic(err)
 			}
 
+			keyring, err := nodekey.New(bytes.Repeat([]byte{1}, nodekey.KeySize))
+			if err != nil {
+				panic(err)
+			}
+
 			pubSub := pubsub.New(db)
 			eventChannel, err := pubSub.Subscribe(
 				context.Background(),
@@ -50,24 +57,42 @@ var _ = Context("core", func() {
 			expectedEvent := model.Event{
 				AuthAdded: &model.AuthAdded{
 					Auth: model.Auth{
-						Creds:     providedReq.Creds,
+						Creds: model.Creds{
+							Username: providedReq.Creds.Username,
+						},
 						Resources: providedReq.Resources,
 					},
 				},
 				Timestamp: time.Now().UTC(),
 			}
 
+			stateStore := mustNewStateStore(
+				context.Background(),
+				db,
+				keyring,
+				pubSub,
+			)
+
 			objectUnderTest := core{
-				pubSub: pubSub,
+				pubSub:     pubSub,
+				stateStore: stateStore,
 			}
 
 			/* act */
-			objectUnderTest.AddAuth(
+			actualErr := objectUnderTest.AddAuth(
 				context.Background(),
 				providedReq,
 			)
 
 			/* assert */
+			Expect(actualErr).To(BeNil())
+			Expect(stateStore.TryGetAuth(providedReq.Resources)).To(Equal(
+				&model.Auth{
+					Creds:     providedReq.Creds,
+					Resources: providedReq.Resources,
+				},
+			))
+
 			var actualEvent model.Event
 			go func() {
 				for event := range eventChannel {
@@ -48,6 +48,11 @@ func (ckr _callKiller) Kill(
 	)
 
 	for _, childCallGraph := range ckr.stateStore.ListWithParentID(callID) {
+		if childCallGraph.IsFinally {
+			// finally calls run to completion even if their parent is killed; they're killed only by requesting so explicitly
+			continue
+		}
+
 		ckr.eventPublisher.Publish(
 			model.Event{
 				CallKillRequested: &model.CallKillRequested{
@@ -10,13 +10,14 @@ import (
 	. "github.com/onsi/gomega"
 	"github.com/opctl/opctl/sdks/go/model"
 	. "github.com/opctl/opctl/sdks/go/node/core/containerruntime/fakes"
+	"github.com/opctl/opctl/sdks/go/node/core/nodekey"
 	"github.com/opctl/opctl/sdks/go/pubsub"
 )
 
 var _ = Context("_callKiller", func() {
 	Context("Kill", func() {
 		Context("stateStore.ListWithParentID returns nodes", func() {
-			It("should call pubsub.Publish for each", func() {
+			It("should call pubsub.Publish for each except finally calls", func() {
 				/* arrange */
 				providedCallID := "providedCallID"
 				providedRootCallID := "providedRootCallID"
@@ -48,7 +49,7 @@ var _ = Context("_callKiller", func() {
 					panic(err)
 				}
 
-				stateStore := newStateStore(context.Background(), db, pubSub)
+				stateStore := mustNewStateStore(context.Background(), db, new(nodekey.Keyring), pubSub)
 
 				// seed call
 				pubSub.Publish(model.Event{
@@ -75,6 +76,20 @@ var _ = Context("_callKiller", func() {
 					})
 				}
 
+				// seed finally call
+				providedFinallyCallID := "finallyCallID"
+				pubSub.Publish(model.Event{
+					CallStarted: &model.CallStarted{
+						Call: model.Call{
+							ID:        providedFinallyCallID,
+							IsFinally: true,
+							ParentID:  &providedCallID,
+							RootID:    providedRootCallID,
+						},
+					},
+					Timestamp: time.Now().UTC(),
+				})
+
 				// give stateStore time to receive & apply events
 				time.Sleep(time.Second)
 
@@ -106,6 +121,11 @@ var _ = Context("_callKiller", func() {
 				).Should(
 					ContainElements(expectedChildCallIDs),
 				)
+				Consistently(
+					func() []string { return actualChildCallIDs },
+				).ShouldNot(
+					ContainElement(providedFinallyCallID),
+				)
 			})
 		})
 	})
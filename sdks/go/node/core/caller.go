@@ -6,6 +6,7 @@ import (
 	"runtime/debug"
 	"time"
 
+	"github.com/opctl/opctl/sdks/go/internal/uniquestring"
 	"github.com/opctl/opctl/sdks/go/model"
 	callpkg "github.com/opctl/opctl/sdks/go/opspec/interpreter/call"
 	"github.com/opctl/opctl/sdks/go/pubsub"
@@ -44,6 +45,11 @@ func newCaller(
 		dataDirPath,
 	)
 
+	instance.dagCaller = newDagCaller(
+		instance,
+		pubSub,
+	)
+
 	instance.parallelCaller = newParallelCaller(
 		instance,
 		pubSub,
@@ -69,6 +75,7 @@ func newCaller(
 
 type _caller struct {
 	containerCaller    containerCaller
+	dagCaller          dagCaller
 	dataDirPath        string
 	opCaller           opCaller
 	parallelCaller     parallelCaller
@@ -90,9 +97,13 @@ func (clr _caller) Call(
 	map[string]*model.Value,
 	error,
 ) {
-	callCtx, cancelCall := context.WithCancel(ctx)
+	isFinally, _ := ctx.Value(isFinallyCtxKey{}).(bool)
+
+	// descendants of finally calls aren't finally calls themselves
+	callCtx, cancelCall := context.WithCancel(context.WithValue(ctx, isFinallyCtxKey{}, false))
 	defer cancelCall()
 	var err error
+	var ignoredErr error
 	var isKilled bool
 	var outputs map[string]*model.Value
 	var call *model.Call
@@ -115,8 +126,8 @@ func (clr _caller) Call(
 
 		event := model.Event{
 			CallEnded: &model.CallEnded{
-				Call:    *call,
-				Outputs: outputs,
+				Call:    redactCall(*call, getSecrets(callCtx)),
+				Outputs: redactValues(outputs, getSecrets(callCtx)),
 				Ref:     opPath,
 			},
 			Timestamp: time.Now().UTC(),
@@ -132,6 +143,11 @@ func (clr _caller) Call(
 			}
 		} else {
 			event.CallEnded.Outcome = model.OpOutcomeSucceeded
+			if ignoredErr != nil {
+				event.CallEnded.IgnoredError = &model.CallEndedError{
+					Message: ignoredErr.Error(),
+				}
+			}
 		}
 
 		clr.pubSub.Publish(event)
@@ -153,10 +169,21 @@ func (clr _caller) Call(
 		clr.dataDirPath,
 	)
 	if err != nil {
+		if callSpec.ContinueOnError {
+			// record the failure but report success to the parent
+			ignoredErr, err = err, nil
+		}
 		return nil, err
 	}
 
-	if call.If != nil && !*call.If {
+	call.IsFinally = isFinally
+
+	if call.Op != nil {
+		// secret inputs of an op are secrets of it & its descendants
+		callCtx = withSecrets(callCtx, call.Op.Secrets)
+	}
+
+	if call.If != nil && !*call.If && call.Else == nil {
 		return outputs, err
 	}
 
@@ -164,7 +191,7 @@ func (clr _caller) Call(
 		model.Event{
 			Timestamp: callStartTime,
 			CallStarted: &model.CallStarted{
-				Call: *call,
+				Call: redactCall(*call, getSecrets(callCtx)),
 				Ref:  opPath,
 			},
 		},
@@ -200,6 +227,15 @@ func (clr _caller) Call(
 	}()
 
 	switch {
+	case call.Else != nil:
+		outputs, err = clr.callChild(
+			callCtx,
+			scope,
+			call.Else,
+			opPath,
+			id,
+			rootCallID,
+		)
 	case callSpec.Container != nil:
 		outputs, err = clr.containerCaller.Call(
 			callCtx,
@@ -208,6 +244,15 @@ func (clr _caller) Call(
 			callSpec.Container,
 			rootCallID,
 		)
+	case callSpec.Dag != nil:
+		outputs, err = clr.dagCaller.Call(
+			callCtx,
+			id,
+			scope,
+			rootCallID,
+			opPath,
+			*callSpec.Dag,
+		)
 	case callSpec.Op != nil:
 		outputs, err = clr.opCaller.Call(
 			callCtx,
@@ -225,6 +270,7 @@ func (clr _caller) Call(
 			rootCallID,
 			opPath,
 			*callSpec.Parallel,
+			callSpec.FailFast == nil || *callSpec.FailFast,
 		)
 	case callSpec.ParallelLoop != nil:
 		outputs, err = clr.parallelLoopCaller.Call(
@@ -244,6 +290,7 @@ func (clr _caller) Call(
 			rootCallID,
 			opPath,
 			*callSpec.Serial,
+			callSpec.Finally,
 		)
 	case callSpec.SerialLoop != nil:
 		outputs, err = clr.serialLoopCaller.Call(
@@ -255,9 +302,53 @@ func (clr _caller) Call(
 			parentCallID,
 			rootCallID,
 		)
+	case callSpec.Switch != nil:
+		if call.Switch.Run != nil {
+			outputs, err = clr.callChild(
+				callCtx,
+				scope,
+				call.Switch.Run,
+				opPath,
+				id,
+				rootCallID,
+			)
+		}
 	default:
 		err = fmt.Errorf("invalid call graph '%+v'", callSpec)
 	}
 
+	if err != nil && callSpec.ContinueOnError {
+		// record the failure but report success to the parent
+		ignoredErr, err = err, nil
+	}
+
 	return outputs, err
 }
+
+// callChild calls callSpec as a child of the call w/ id parentCallID
+func (clr _caller) callChild(
+	ctx context.Context,
+	scope map[string]*model.Value,
+	callSpec *model.CallSpec,
+	opPath string,
+	parentCallID string,
+	rootCallID string,
+) (
+	map[string]*model.Value,
+	error,
+) {
+	childCallID, err := uniquestring.Construct()
+	if err != nil {
+		return nil, err
+	}
+
+	return clr.Call(
+		ctx,
+		childCallID,
+		scope,
+		callSpec,
+		opPath,
+		&parentCallID,
+		rootCallID,
+	)
+}
@@ -2,15 +2,18 @@ package core
 
 import (
 	"context"
+	"errors"
 	"io/ioutil"
 	"os"
 	"path/filepath"
 	"time"
 
+	"github.com/dgraph-io/badger/v2"
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
 	"github.com/opctl/opctl/sdks/go/model"
 	. "github.com/opctl/opctl/sdks/go/node/core/internal/fakes"
+	"github.com/opctl/opctl/sdks/go/pubsub"
 	. "github.com/opctl/opctl/sdks/go/pubsub/fakes"
 )
 
@@ -236,6 +239,7 @@ var _ = Context("caller", func() {
 						BaseCall: model.BaseCall{
 							OpPath: providedOpPath,
 						},
+						Ref:    providedOpPath,
 						OpID:   providedCallID,
 						Inputs: map[string]*model.Value{},
 					},
@@ -287,6 +291,321 @@ var _ = Context("caller", func() {
 			})
 		})
 
+		Context("callInterpreter.Interpret result.If falsy & CallSpec.Else not nil", func() {
+			It("should call else as child", func() {
+				/* arrange */
+				isFalse := []interface{}{true, false}
+				ifSpec := []*model.PredicateSpec{{Eq: &isFalse}}
+				providedElseSerial := []*model.CallSpec{}
+
+				fakePubSub := new(FakePubSub)
+				// ensure eventChan closed so call exits
+				fakePubSub.SubscribeReturns(closedEventChan, nil)
+
+				fakeSerialCaller := new(FakeSerialCaller)
+				fakeSerialCaller.CallReturns(map[string]*model.Value{"elseOutput": {}}, nil)
+
+				objectUnderTest := _caller{
+					containerCaller: new(FakeContainerCaller),
+					pubSub:          fakePubSub,
+					serialCaller:    fakeSerialCaller,
+				}
+
+				/* act */
+				actualOutputs, actualErr := objectUnderTest.Call(
+					context.Background(),
+					"providedCallID",
+					map[string]*model.Value{},
+					&model.CallSpec{
+						Container: &model.ContainerCallSpec{},
+						Else:      &model.CallSpec{Serial: &providedElseSerial},
+						If:        &ifSpec,
+					},
+					"dummyOpPath",
+					nil,
+					"dummyRootCallID",
+				)
+
+				/* assert */
+				Expect(actualErr).To(BeNil())
+				Expect(actualOutputs).To(Equal(map[string]*model.Value{"elseOutput": {}}))
+
+				_, actualChildCallID, _, _, _, actualCallSpec, _ := fakeSerialCaller.CallArgsForCall(0)
+				Expect(actualChildCallID).To(Not(Equal("providedCallID")))
+				Expect(actualCallSpec).To(Equal(providedElseSerial))
+
+				actualCallStarted := fakePubSub.PublishArgsForCall(0).CallStarted
+				Expect(actualCallStarted.Call.ID).To(Equal("providedCallID"))
+				Expect(*actualCallStarted.Call.If).To(BeFalse())
+			})
+		})
+
+		Context("Switch CallSpec", func() {
+			It("should call matched case as child", func() {
+				/* arrange */
+				isTrue := []interface{}{true, true}
+				providedCaseSerial := []*model.CallSpec{}
+
+				fakePubSub := new(FakePubSub)
+				// ensure eventChan closed so call exits
+				fakePubSub.SubscribeReturns(closedEventChan, nil)
+
+				fakeSerialCaller := new(FakeSerialCaller)
+
+				objectUnderTest := _caller{
+					pubSub:       fakePubSub,
+					serialCaller: fakeSerialCaller,
+				}
+
+				/* act */
+				objectUnderTest.Call(
+					context.Background(),
+					"providedCallID",
+					map[string]*model.Value{},
+					&model.CallSpec{
+						Switch: &model.SwitchCallSpec{
+							Cases: []*model.SwitchCaseSpec{
+								{
+									If:  []*model.PredicateSpec{{Eq: &isTrue}},
+									Run: model.CallSpec{Serial: &providedCaseSerial},
+								},
+							},
+						},
+					},
+					"dummyOpPath",
+					nil,
+					"dummyRootCallID",
+				)
+
+				/* assert */
+				_, _, _, _, _, actualCallSpec, _ := fakeSerialCaller.CallArgsForCall(0)
+				Expect(actualCallSpec).To(Equal(providedCaseSerial))
+
+				actualCallStarted := fakePubSub.PublishArgsForCall(0).CallStarted
+				Expect(*actualCallStarted.Call.Switch.Case).To(Equal(0))
+			})
+		})
+
+		Context("CallSpec.ContinueOnError true & call fails", func() {
+			It("should report success w/ ignored error", func() {
+				/* arrange */
+				fakeSerialCaller := new(FakeSerialCaller)
+				fakeSerialCaller.CallReturns(nil, errors.New("providedErr"))
+
+				fakePubSub := new(FakePubSub)
+				// ensure eventChan closed so call exits
+				fakePubSub.SubscribeReturns(closedEventChan, nil)
+
+				objectUnderTest := _caller{
+					pubSub:       fakePubSub,
+					serialCaller: fakeSerialCaller,
+				}
+
+				/* act */
+				_, actualErr := objectUnderTest.Call(
+					context.Background(),
+					"dummyCallID",
+					map[string]*model.Value{},
+					&model.CallSpec{
+						ContinueOnError: true,
+						Serial:          &[]*model.CallSpec{},
+					},
+					"dummyOpPath",
+					nil,
+					"dummyRootCallID",
+				)
+
+				/* assert */
+				Expect(actualErr).To(BeNil())
+
+				actualEvent := fakePubSub.PublishArgsForCall(fakePubSub.PublishCallCount() - 1)
+				Expect(actualEvent.CallEnded.Outcome).To(Equal(model.OpOutcomeSucceeded))
+				Expect(actualEvent.CallEnded.Error).To(BeNil())
+				Expect(*actualEvent.CallEnded.IgnoredError).To(Equal(model.CallEndedError{Message: "providedErr"}))
+			})
+		})
+
+		Context("finally call of killed call", func() {
+			It("should run until killed itself", func() {
+				/* arrange */
+				providedCallID := "dummyCallID"
+				providedRootCallID := "dummyRootCallID"
+
+				eventChan := make(chan model.Event, 1)
+				fakePubSub := new(FakePubSub)
+				fakePubSub.SubscribeReturns(eventChan, nil)
+
+				fakeSerialCaller := new(FakeSerialCaller)
+				fakeSerialCaller.CallStub = func(
+					ctx context.Context,
+					id string,
+					scope map[string]*model.Value,
+					rootCallID string,
+					opPath string,
+					callSpecs []*model.CallSpec,
+					finallyCallSpec *model.CallSpec,
+				) (map[string]*model.Value, error) {
+					// request kill of the finally call itself
+					eventChan <- model.Event{
+						CallKillRequested: &model.CallKillRequested{
+							Request: model.KillOpReq{
+								OpID:       providedCallID,
+								RootCallID: providedRootCallID,
+							},
+						},
+					}
+
+					select {
+					case <-ctx.Done():
+						return nil, nil
+					case <-time.After(5 * time.Second):
+						return nil, errors.New("not killed")
+					}
+				}
+
+				objectUnderTest := _caller{
+					pubSub:       fakePubSub,
+					serialCaller: fakeSerialCaller,
+				}
+
+				// the call finally follows was killed
+				killedCtx, cancel := context.WithCancel(context.Background())
+				cancel()
+
+				/* act */
+				_, actualErr := objectUnderTest.Call(
+					context.WithValue(detachedCtx{killedCtx}, isFinallyCtxKey{}, true),
+					providedCallID,
+					map[string]*model.Value{},
+					&model.CallSpec{
+						Serial: &[]*model.CallSpec{},
+					},
+					"dummyOpPath",
+					nil,
+					providedRootCallID,
+				)
+
+				/* assert */
+				Expect(actualErr).To(BeNil())
+				Expect(fakeSerialCaller.CallCallCount()).To(Equal(1))
+
+				actualEvent := fakePubSub.PublishArgsForCall(fakePubSub.PublishCallCount() - 1)
+				Expect(actualEvent.CallEnded.Call.IsFinally).To(BeTrue())
+				Expect(actualEvent.CallEnded.Outcome).To(Equal(model.OpOutcomeKilled))
+			})
+		})
+
+		Context("outputs contain secrets", func() {
+			It("should mask secrets in CallEnded.Outputs", func() {
+				/* arrange */
+				secret := "providedSecret"
+				notSecret := "providedNotSecret"
+				mask := "***"
+
+				fakeSerialCaller := new(FakeSerialCaller)
+				// serial calls output their inbound scope, including secret inputs
+				fakeSerialCaller.CallReturns(
+					map[string]*model.Value{
+						"secret":    {String: &secret},
+						"notSecret": {String: &notSecret},
+					},
+					nil,
+				)
+
+				fakePubSub := new(FakePubSub)
+				// ensure eventChan closed so call exits
+				fakePubSub.SubscribeReturns(closedEventChan, nil)
+
+				objectUnderTest := _caller{
+					pubSub:       fakePubSub,
+					serialCaller: fakeSerialCaller,
+				}
+
+				/* act */
+				actualOutputs, actualErr := objectUnderTest.Call(
+					withSecrets(context.Background(), []string{secret}),
+					"dummyCallID",
+					map[string]*model.Value{},
+					&model.CallSpec{
+						Serial: &[]*model.CallSpec{},
+					},
+					"dummyOpPath",
+					nil,
+					"dummyRootCallID",
+				)
+
+				/* assert */
+				Expect(actualErr).To(BeNil())
+				// outputs returned to the parent are left as is
+				Expect(*actualOutputs["secret"].String).To(Equal(secret))
+
+				actualEvent := fakePubSub.PublishArgsForCall(fakePubSub.PublishCallCount() - 1)
+				Expect(actualEvent.CallEnded.Outputs).To(Equal(map[string]*model.Value{
+					"secret":    {String: &mask},
+					"notSecret": {String: &notSecret},
+				}))
+			})
+			It("should pass secrets through nested serial calls as is", func() {
+				/* arrange */
+				secret := "providedSecret"
+
+				dbDir, err := ioutil.TempDir("", "")
+				if err != nil {
+					panic(err)
+				}
+
+				db, err := badger.Open(
+					badger.DefaultOptions(dbDir).WithLogger(nil),
+				)
+				if err != nil {
+					panic(err)
+				}
+
+				fakeContainerCaller := new(FakeContainerCaller)
+
+				objectUnderTest := newCaller(
+					fakeContainerCaller,
+					dbDir,
+					pubsub.New(db),
+				)
+
+				/* act */
+				_, actualErr := objectUnderTest.Call(
+					withSecrets(context.Background(), []string{secret}),
+					"dummyCallID",
+					map[string]*model.Value{
+						"secret": {String: &secret},
+					},
+					&model.CallSpec{
+						Serial: &[]*model.CallSpec{
+							{
+								// serial calls output their inbound scope, including secrets
+								Serial: &[]*model.CallSpec{
+									{Serial: &[]*model.CallSpec{}},
+								},
+							},
+							{
+								Container: &model.ContainerCallSpec{
+									Image: &model.ContainerCallImageSpec{
+										Ref: "docker.io/library/ref",
+									},
+								},
+							},
+						},
+					},
+					"dummyOpPath",
+					nil,
+					"dummyRootCallID",
+				)
+
+				/* assert */
+				Expect(actualErr).To(BeNil())
+
+				_, _, actualScope, _, _ := fakeContainerCaller.CallArgsForCall(0)
+				Expect(*actualScope["secret"].String).To(Equal(secret))
+			})
+		})
+
 		Context("Parallel CallSpec", func() {
 			It("should call parallelCaller.Call w/ expected args", func() {
 				/* arrange */
@@ -328,13 +647,15 @@ var _ = Context("caller", func() {
 					actualScope,
 					actualRootCallID,
 					actualOpPath,
-					actualCallSpec := fakeParallelCaller.CallArgsForCall(0)
+					actualCallSpec,
+					actualIsFailFast := fakeParallelCaller.CallArgsForCall(0)
 
 				Expect(actualCallID).To(Equal(providedCallID))
 				Expect(actualScope).To(Equal(providedScope))
 				Expect(actualRootCallID).To(Equal(providedRootCallID))
 				Expect(actualOpPath).To(Equal(providedOpPath))
 				Expect(actualCallSpec).To(Equal(*providedCallSpec.Parallel))
+				Expect(actualIsFailFast).To(BeTrue())
 			})
 		})
 
@@ -433,13 +754,15 @@ var _ = Context("caller", func() {
 					actualScope,
 					actualRootCallID,
 					actualOpPath,
-					actualCallSpec := fakeSerialCaller.CallArgsForCall(0)
+					actualCallSpec,
+					actualCallSpecFinally := fakeSerialCaller.CallArgsForCall(0)
 
 				Expect(actualCallID).To(Equal(providedCallID))
 				Expect(actualScope).To(Equal(providedScope))
 				Expect(actualRootCallID).To(Equal(providedRootCallID))
 				Expect(actualOpPath).To(Equal(providedOpPath))
 				Expect(actualCallSpec).To(Equal(*providedCallSpec.Serial))
+				Expect(actualCallSpecFinally).To(BeNil())
 			})
 		})
 
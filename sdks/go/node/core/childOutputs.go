@@ -0,0 +1,62 @@
+package core
+
+import (
+	"sync"
+
+	"github.com/opctl/opctl/sdks/go/model"
+)
+
+// childOutputs holds outputs returned by child calls made concurrently.
+//
+// CallEnded events carry outputs w/ secrets masked (they're persisted & streamed) so parents
+// learn a child ended from its CallEnded event but read its outputs from here.
+type childOutputs struct {
+	doneByID    map[string]chan struct{}
+	mutex       sync.Mutex
+	outputsByID map[string]map[string]*model.Value
+}
+
+func newChildOutputs() *childOutputs {
+	return &childOutputs{
+		doneByID:    map[string]chan struct{}{},
+		outputsByID: map[string]map[string]*model.Value{},
+	}
+}
+
+func (co *childOutputs) done(
+	id string,
+) chan struct{} {
+	co.mutex.Lock()
+	defer co.mutex.Unlock()
+
+	if _, ok := co.doneByID[id]; !ok {
+		co.doneByID[id] = make(chan struct{})
+	}
+	return co.doneByID[id]
+}
+
+// Set records the outputs returned by the child call w/ id; MUST be called exactly once per child call
+func (co *childOutputs) Set(
+	id string,
+	outputs map[string]*model.Value,
+) {
+	done := co.done(id)
+
+	co.mutex.Lock()
+	co.outputsByID[id] = outputs
+	co.mutex.Unlock()
+
+	close(done)
+}
+
+// Get waits for & returns the outputs returned by the child call w/ id
+func (co *childOutputs) Get(
+	id string,
+) map[string]*model.Value {
+	<-co.done(id)
+
+	co.mutex.Lock()
+	defer co.mutex.Unlock()
+
+	return co.outputsByID[id]
+}
@@ -6,6 +6,7 @@ import (
 	"io"
 	"time"
 
+	"github.com/opctl/opctl/sdks/go/internal/redact"
 	"github.com/opctl/opctl/sdks/go/model"
 	"github.com/opctl/opctl/sdks/go/node/core/containerruntime"
 	"github.com/opctl/opctl/sdks/go/opspec"
@@ -77,6 +78,7 @@ func (cc _containerCaller) Call(
 			logStdErrPR,
 			containerCall,
 			rootCallID,
+			getSecrets(ctx),
 		)
 	}()
 
@@ -117,45 +119,66 @@ func (this _containerCaller) interpretLogs(
 	stdErrReader io.Reader,
 	containerCall *model.ContainerCall,
 	rootCallID string,
+	secrets []string,
 ) error {
 	stdOutLogChan := make(chan error, 1)
 	go func() {
+		publishStdOut := func(chunk []byte) {
+			if len(chunk) == 0 {
+				return
+			}
+			this.pubSub.Publish(
+				model.Event{
+					Timestamp: time.Now().UTC(),
+					ContainerStdOutWrittenTo: &model.ContainerStdOutWrittenTo{
+						Data:        chunk,
+						ContainerID: containerCall.ContainerID,
+						OpRef:       containerCall.OpPath,
+						RootCallID:  rootCallID,
+					},
+				},
+			)
+		}
+
 		// interpret stdOut
-		stdOutLogChan <- readChunks(
+		redactor := redact.New(secrets)
+		err := readChunks(
 			stdOutReader,
 			func(chunk []byte) {
-				this.pubSub.Publish(
-					model.Event{
-						Timestamp: time.Now().UTC(),
-						ContainerStdOutWrittenTo: &model.ContainerStdOutWrittenTo{
-							Data:        chunk,
-							ContainerID: containerCall.ContainerID,
-							OpRef:       containerCall.OpPath,
-							RootCallID:  rootCallID,
-						},
-					},
-				)
+				publishStdOut(redactor.Redact(chunk))
 			})
+		publishStdOut(redactor.Flush())
+		stdOutLogChan <- err
 	}()
 
 	stdErrLogChan := make(chan error, 1)
 	go func() {
+		publishStdErr := func(chunk []byte) {
+			if len(chunk) == 0 {
+				return
+			}
+			this.pubSub.Publish(
+				model.Event{
+					Timestamp: time.Now().UTC(),
+					ContainerStdErrWrittenTo: &model.ContainerStdErrWrittenTo{
+						Data:        chunk,
+						ContainerID: containerCall.ContainerID,
+						OpRef:       containerCall.OpPath,
+						RootCallID:  rootCallID,
+					},
+				},
+			)
+		}
+
 		// interpret stdErr
-		stdErrLogChan <- readChunks(
+		redactor := redact.New(secrets)
+		err := readChunks(
 			stdErrReader,
 			func(chunk []byte) {
-				this.pubSub.Publish(
-					model.Event{
-						Timestamp: time.Now().UTC(),
-						ContainerStdErrWrittenTo: &model.ContainerStdErrWrittenTo{
-							Data:        chunk,
-							ContainerID: containerCall.ContainerID,
-							OpRef:       containerCall.OpPath,
-							RootCallID:  rootCallID,
-						},
-					},
-				)
+				publishStdErr(redactor.Redact(chunk))
 			})
+		publishStdErr(redactor.Flush())
+		stdErrLogChan <- err
 	}()
 
 	// wait on logs
@@ -11,6 +11,7 @@ import (
 	. "github.com/onsi/gomega"
 	"github.com/opctl/opctl/sdks/go/model"
 	. "github.com/opctl/opctl/sdks/go/node/core/containerruntime/fakes"
+	"github.com/opctl/opctl/sdks/go/node/core/nodekey"
 	"github.com/opctl/opctl/sdks/go/pubsub"
 	. "github.com/opctl/opctl/sdks/go/pubsub/fakes"
 )
@@ -34,7 +35,7 @@ var _ = Context("containerCaller", func() {
 			Expect(newContainerCaller(
 				new(FakeContainerRuntime),
 				new(FakePubSub),
-				newStateStore(context.Background(), db, new(FakePubSub)),
+				mustNewStateStore(context.Background(), db, new(nodekey.Keyring), new(FakePubSub)),
 			)).To(Not(BeNil()))
 		})
 	})
@@ -91,6 +92,69 @@ var _ = Context("containerCaller", func() {
 			Expect(actualRootCallID).To(Equal(providedRootCallID))
 			Expect(actualEventPublisher).To(Equal(fakePubSub))
 		})
+		Context("ctx has secrets", func() {
+			It("should publish logs w/ secrets redacted", func() {
+				/* arrange */
+				secret := "hunter2"
+				providedCtx := withSecrets(
+					context.Background(),
+					[]string{secret},
+				)
+
+				fakeContainerRuntime := new(FakeContainerRuntime)
+				fakeContainerRuntime.RunContainerStub = func(
+					ctx context.Context,
+					req *model.ContainerCall,
+					rootCallID string,
+					eventPublisher pubsub.EventPublisher,
+					stdOut io.WriteCloser,
+					stdErr io.WriteCloser,
+				) (*int64, error) {
+					io.WriteString(stdOut, "password is hunt")
+					io.WriteString(stdOut, "er2\n")
+					io.WriteString(stdErr, "error: hunter2\n")
+
+					stdErr.Close()
+					stdOut.Close()
+
+					return nil, nil
+				}
+
+				fakePubSub := new(FakePubSub)
+
+				objectUnderTest := _containerCaller{
+					containerRuntime: fakeContainerRuntime,
+					pubSub:           fakePubSub,
+				}
+
+				/* act */
+				objectUnderTest.Call(
+					providedCtx,
+					&model.ContainerCall{
+						BaseCall: model.BaseCall{},
+						Image:    &model.ContainerCallImage{},
+					},
+					map[string]*model.Value{},
+					&model.ContainerCallSpec{},
+					"rootCallID",
+				)
+
+				/* assert */
+				actualStdOut := ""
+				actualStdErr := ""
+				for i := 0; i < fakePubSub.PublishCallCount(); i++ {
+					actualEvent := fakePubSub.PublishArgsForCall(i)
+					if actualEvent.ContainerStdOutWrittenTo != nil {
+						actualStdOut += string(actualEvent.ContainerStdOutWrittenTo.Data)
+					}
+					if actualEvent.ContainerStdErrWrittenTo != nil {
+						actualStdErr += string(actualEvent.ContainerStdErrWrittenTo.Data)
+					}
+				}
+				Expect(actualStdOut).To(Equal("password is ***\n"))
+				Expect(actualStdErr).To(Equal("error: ***\n"))
+			})
+		})
 		Context("containerRuntime.RunContainer errors", func() {
 			It("should publish expected ContainerExited", func() {
 				/* arrange */
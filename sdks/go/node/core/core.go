@@ -15,19 +15,23 @@ import (
 	"github.com/opctl/opctl/sdks/go/model"
 	"github.com/opctl/opctl/sdks/go/node"
 	"github.com/opctl/opctl/sdks/go/node/core/containerruntime"
+	"github.com/opctl/opctl/sdks/go/node/core/nodekey"
+	"github.com/opctl/opctl/sdks/go/opspec/signature"
 	"github.com/opctl/opctl/sdks/go/pubsub"
 )
 
-// New returns a new LocalCore initialized with the given options
+// New returns a new LocalCore initialized with the given options;
+// keyring is used to encrypt auth at rest
 func New(
 	ctx context.Context,
 	containerRuntime containerruntime.ContainerRuntime,
 	dataDirPath string,
-) Core {
+	keyring *nodekey.Keyring,
+) (Core, error) {
 	eventDbPath := path.Join(dataDirPath, "dcg", "events")
 	err := os.MkdirAll(eventDbPath, 0700)
 	if err != nil {
-		panic(err)
+		return nil, err
 	}
 
 	// per badger README.MD#FAQ "maximizes throughput"
@@ -39,16 +43,20 @@ func New(
 		).WithLogger(nil),
 	)
 	if err != nil {
-		panic(err)
+		return nil, err
 	}
 
 	pubSub := pubsub.New(db)
 
-	stateStore := newStateStore(
+	stateStore, err := newStateStore(
 		ctx,
 		db,
+		keyring,
 		pubSub,
 	)
+	if err != nil {
+		return nil, err
+	}
 
 	caller := newCaller(
 		newContainerCaller(
@@ -97,9 +105,10 @@ func New(
 			caller,
 			dataDirPath,
 		),
-		pubSub:     pubSub,
-		stateStore: stateStore,
-	}
+		pubSub:          pubSub,
+		stateStore:      stateStore,
+		trustedKeysPath: filepath.Join(dataDirPath, signature.TrustedKeysDirName),
+	}, nil
 }
 
 // core is an Node that supports running ops directly on the host
@@ -110,6 +119,8 @@ type core struct {
 	opCaller         opCaller
 	pubSub           pubsub.PubSub
 	stateStore       stateStore
+	// trustedKeysPath is the path of the dir of public keys pulled ops must be signed by
+	trustedKeysPath string
 }
 
 func (c core) Liveness(
@@ -0,0 +1,231 @@
+package core
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"runtime/debug"
+	"time"
+
+	"github.com/opctl/opctl/sdks/go/internal/uniquestring"
+	"github.com/opctl/opctl/sdks/go/model"
+	"github.com/opctl/opctl/sdks/go/opspec/interpreter/call/dag"
+	"github.com/opctl/opctl/sdks/go/pubsub"
+)
+
+//counterfeiter:generate -o internal/fakes/dagCaller.go . dagCaller
+type dagCaller interface {
+	// Executes a dag call
+	Call(
+		parentCtx context.Context,
+		callID string,
+		inboundScope map[string]*model.Value,
+		rootCallID string,
+		opPath string,
+		callSpecDagCall []*model.CallSpec,
+	) (
+		map[string]*model.Value,
+		error,
+	)
+}
+
+func newDagCaller(
+	caller caller,
+	pubSub pubsub.PubSub,
+) dagCaller {
+
+	return _dagCaller{
+		caller: caller,
+		pubSub: pubSub,
+	}
+
+}
+
+type _dagCaller struct {
+	caller caller
+	pubSub pubsub.PubSub
+}
+
+func (dc _dagCaller) Call(
+	parentCtx context.Context,
+	callID string,
+	inboundScope map[string]*model.Value,
+	rootCallID string,
+	opPath string,
+	callSpecDagCall []*model.CallSpec,
+) (
+	map[string]*model.Value,
+	error,
+) {
+	// setup cancellation
+	dagCtx, cancelDag := context.WithCancel(parentCtx)
+	defer cancelDag()
+
+	neededIndicesByIndex, err := dag.Needs(callSpecDagCall)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(callSpecDagCall) == 0 {
+		return map[string]*model.Value{}, nil
+	}
+
+	startTime := time.Now().UTC()
+	childCallIndexByID := map[string]int{}
+	childCallIDByIndex := map[int]string{}
+	childCallOutputsByIndex := map[int]map[string]*model.Value{}
+	childCallOutputs := newChildOutputs()
+	isChildSucceededByIndex := map[int]bool{}
+	isChildSkippedByIndex := map[int]bool{}
+
+	// subscribe to events
+	// @TODO: handle err channel
+	eventChannel, _ := dc.pubSub.Subscribe(
+		// don't cancel w/ children; we need to read err msgs
+		parentCtx,
+		model.EventFilter{
+			Roots: []string{rootCallID},
+			Since: &startTime,
+		},
+	)
+
+	// startReadyChildCalls starts each child call which hasn't started & whose needs all succeeded;
+	// children needing a call which didn't succeed are skipped
+	startReadyChildCalls := func() error {
+		if dagCtx.Err() != nil {
+			// cancelled; start nothing more
+			return nil
+		}
+
+		for childCallIndex, childCall := range callSpecDagCall {
+			if _, isStarted := childCallIDByIndex[childCallIndex]; isStarted || isChildSkippedByIndex[childCallIndex] {
+				continue
+			}
+
+			isReady := true
+			for _, neededIndex := range neededIndicesByIndex[childCallIndex] {
+				if isChildSkippedByIndex[neededIndex] {
+					isChildSkippedByIndex[childCallIndex] = true
+					isReady = false
+					break
+				}
+				if _, isNeededEnded := childCallOutputsByIndex[neededIndex]; isNeededEnded && !isChildSucceededByIndex[neededIndex] {
+					isChildSkippedByIndex[childCallIndex] = true
+					isReady = false
+					break
+				}
+				if !isChildSucceededByIndex[neededIndex] {
+					isReady = false
+				}
+			}
+			if !isReady {
+				continue
+			}
+
+			childCallID, err := uniquestring.Construct()
+			if err != nil {
+				return err
+			}
+
+			childCallIndexByID[childCallID] = childCallIndex
+			childCallIDByIndex[childCallIndex] = childCallID
+
+			// scope children w/ the outputs of the calls they need (directly or transitively)
+			childCallScope := map[string]*model.Value{}
+			for varName, varData := range inboundScope {
+				childCallScope[varName] = varData
+			}
+			for _, ancestorIndex := range dag.Ancestors(neededIndicesByIndex, childCallIndex) {
+				for varName, varData := range childCallOutputsByIndex[ancestorIndex] {
+					childCallScope[varName] = varData
+				}
+			}
+
+			go func(childCall *model.CallSpec) {
+				var outputs map[string]*model.Value
+				defer func() {
+					childCallOutputs.Set(childCallID, outputs)
+				}()
+				defer func() {
+					if panicArg := recover(); panicArg != nil {
+						// recover from panics; treat as errors
+						fmt.Printf("%v\n%v", panicArg, debug.Stack())
+
+						// cancel all children on any error
+						cancelDag()
+					}
+				}()
+
+				outputs, _ = dc.caller.Call(
+					dagCtx,
+					childCallID,
+					childCallScope,
+					childCall,
+					opPath,
+					&callID,
+					rootCallID,
+				)
+
+			}(childCall)
+		}
+
+		return nil
+	}
+
+	if err := startReadyChildCalls(); err != nil {
+		// end run immediately on any error
+		return nil, err
+	}
+
+	var isChildErred = false
+	outputs := map[string]*model.Value{}
+
+eventLoop:
+	for event := range eventChannel {
+		if event.CallEnded != nil {
+			if childCallIndex, isChildCallEnded := childCallIndexByID[event.CallEnded.Call.ID]; isChildCallEnded {
+				// outputs of CallEnded events are redacted so take them from the call
+				childCallOutputsByIndex[childCallIndex] = childCallOutputs.Get(event.CallEnded.Call.ID)
+				if event.CallEnded.Error != nil {
+					// unlike parallel calls, failures don't cancel siblings; only dependents are skipped
+					isChildErred = true
+				}
+				isChildSucceededByIndex[childCallIndex] = event.CallEnded.Outcome == model.OpOutcomeSucceeded
+
+				// repeat until no more children are skipped so skips propagate to transitive dependents
+				for skippedCount := -1; skippedCount != len(isChildSkippedByIndex); {
+					skippedCount = len(isChildSkippedByIndex)
+					if err := startReadyChildCalls(); err != nil {
+						// end run immediately on any error
+						return nil, err
+					}
+				}
+			}
+
+			if len(childCallOutputsByIndex) == len(childCallIndexByID) {
+				// all started calls have ended; no more can start
+
+				// construct dag outputs
+				for i := 0; i < len(callSpecDagCall); i++ {
+					callOutputs := childCallOutputsByIndex[i]
+					for varName, varData := range callOutputs {
+						outputs[varName] = varData
+					}
+				}
+
+				if isChildErred {
+					return nil, errors.New("child call failed")
+				}
+
+				if len(isChildSkippedByIndex) > 0 {
+					return nil, errors.New("child call skipped; a call it needs didn't succeed")
+				}
+
+				break eventLoop
+			}
+
+		}
+	}
+
+	return outputs, nil
+}
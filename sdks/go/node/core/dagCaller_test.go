@@ -0,0 +1,223 @@
+package core
+
+import (
+	"context"
+	"errors"
+	"io/ioutil"
+	"sync"
+	"time"
+
+	"github.com/dgraph-io/badger/v2"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/opctl/opctl/sdks/go/model"
+	. "github.com/opctl/opctl/sdks/go/node/core/internal/fakes"
+	"github.com/opctl/opctl/sdks/go/pubsub"
+	. "github.com/opctl/opctl/sdks/go/pubsub/fakes"
+)
+
+var _ = Context("dagCaller", func() {
+	Context("newDagCaller", func() {
+		It("should return dagCaller", func() {
+			/* arrange/act/assert */
+			Expect(newDagCaller(
+				new(FakeCaller),
+				new(FakePubSub),
+			)).To(Not(BeNil()))
+		})
+	})
+
+	Context("Call", func() {
+		newPubSub := func() pubsub.PubSub {
+			dbDir, err := ioutil.TempDir("", "")
+			if err != nil {
+				panic(err)
+			}
+
+			db, err := badger.Open(
+				badger.DefaultOptions(dbDir).WithLogger(nil),
+			)
+			if err != nil {
+				panic(err)
+			}
+			return pubsub.New(db)
+		}
+
+		callSpec := func(name string, needs ...string) *model.CallSpec {
+			return &model.CallSpec{
+				Name:  &name,
+				Needs: needs,
+			}
+		}
+
+		// newFakeCaller returns a caller which outputs a var named after the call & fails calls named in failingNames;
+		// the scope each call was called w/ is recorded in calledScopesByName
+		newFakeCaller := func(
+			pubSub pubsub.PubSub,
+			calledScopesByName map[string]map[string]*model.Value,
+			calledScopesMutex *sync.Mutex,
+			failingNames ...string,
+		) *FakeCaller {
+			fakeCaller := new(FakeCaller)
+			fakeCaller.CallStub = func(
+				ctx context.Context,
+				id string,
+				scope map[string]*model.Value,
+				callSpec *model.CallSpec,
+				opPath string,
+				parentCallID *string,
+				rootCallID string,
+			) (map[string]*model.Value, error) {
+				calledScopesMutex.Lock()
+				calledScopesByName[*callSpec.Name] = scope
+				calledScopesMutex.Unlock()
+
+				output := *callSpec.Name
+				callEnded := &model.CallEnded{
+					Call: model.Call{
+						ID:     id,
+						RootID: rootCallID,
+					},
+					Outcome: model.OpOutcomeSucceeded,
+					Outputs: map[string]*model.Value{
+						*callSpec.Name: {String: &output},
+					},
+				}
+
+				var err error
+				for _, failingName := range failingNames {
+					if failingName == *callSpec.Name {
+						err = errors.New("failed")
+						callEnded.Outcome = model.OpOutcomeFailed
+						callEnded.Error = &model.CallEndedError{Message: err.Error()}
+					}
+				}
+
+				pubSub.Publish(
+					model.Event{
+						CallEnded: callEnded,
+						Timestamp: time.Now().UTC(),
+					},
+				)
+
+				return callEnded.Outputs, err
+			}
+			return fakeCaller
+		}
+
+		Context("needs form a cycle", func() {
+			It("should return expected result", func() {
+				/* arrange */
+				fakeCaller := new(FakeCaller)
+
+				objectUnderTest := _dagCaller{
+					caller: fakeCaller,
+					pubSub: new(FakePubSub),
+				}
+
+				/* act */
+				_, actualErr := objectUnderTest.Call(
+					context.Background(),
+					"callID",
+					map[string]*model.Value{},
+					"rootCallID",
+					"opPath",
+					[]*model.CallSpec{
+						callSpec("a", "b"),
+						callSpec("b", "a"),
+					},
+				)
+
+				/* assert */
+				Expect(actualErr).To(MatchError("unable to interpret needs: needs form a cycle 'a -> b -> a'"))
+				Expect(fakeCaller.CallCallCount()).To(Equal(0))
+			})
+		})
+
+		Context("all calls succeed", func() {
+			It("should call dependents w/ outputs of their needs in scope", func() {
+				/* arrange */
+				pubSub := newPubSub()
+				calledScopesByName := map[string]map[string]*model.Value{}
+				fakeCaller := newFakeCaller(pubSub, calledScopesByName, &sync.Mutex{})
+
+				inboundValue := "inbound"
+				providedScope := map[string]*model.Value{
+					"inbound": {String: &inboundValue},
+				}
+
+				objectUnderTest := _dagCaller{
+					caller: fakeCaller,
+					pubSub: pubSub,
+				}
+
+				/* act */
+				actualOutputs, actualErr := objectUnderTest.Call(
+					context.Background(),
+					"callID",
+					providedScope,
+					"rootCallID",
+					"opPath",
+					[]*model.CallSpec{
+						callSpec("c", "b"),
+						callSpec("b", "$(a)"),
+						callSpec("a"),
+						callSpec("d"),
+					},
+				)
+
+				/* assert */
+				Expect(actualErr).To(BeNil())
+				Expect(actualOutputs).To(HaveLen(4))
+
+				Expect(calledScopesByName["a"]).To(Equal(providedScope))
+				Expect(calledScopesByName["d"]).To(Equal(providedScope))
+				Expect(calledScopesByName["b"]).To(HaveKey("a"))
+				Expect(calledScopesByName["b"]).To(Not(HaveKey("d")))
+				Expect(calledScopesByName["c"]).To(HaveKey("inbound"))
+				Expect(calledScopesByName["c"]).To(HaveKey("a"))
+				Expect(calledScopesByName["c"]).To(HaveKey("b"))
+			})
+		})
+
+		Context("call fails", func() {
+			It("should skip its dependents but not other calls", func() {
+				/* arrange */
+				pubSub := newPubSub()
+				calledScopesByName := map[string]map[string]*model.Value{}
+				fakeCaller := newFakeCaller(pubSub, calledScopesByName, &sync.Mutex{}, "a")
+
+				objectUnderTest := _dagCaller{
+					caller: fakeCaller,
+					pubSub: pubSub,
+				}
+
+				/* act */
+				actualOutputs, actualErr := objectUnderTest.Call(
+					context.Background(),
+					"callID",
+					map[string]*model.Value{},
+					"rootCallID",
+					"opPath",
+					[]*model.CallSpec{
+						callSpec("a"),
+						callSpec("b", "a"),
+						callSpec("c", "b"),
+						callSpec("d"),
+						callSpec("e", "d"),
+					},
+				)
+
+				/* assert */
+				Expect(actualErr).To(MatchError("child call failed"))
+				Expect(actualOutputs).To(BeNil())
+
+				Expect(calledScopesByName).To(HaveKey("a"))
+				Expect(calledScopesByName).To(Not(HaveKey("b")))
+				Expect(calledScopesByName).To(Not(HaveKey("c")))
+				Expect(calledScopesByName).To(HaveKey("d"))
+				Expect(calledScopesByName).To(HaveKey("e"))
+			})
+		})
+	})
+})
@@ -0,0 +1,79 @@
+package core
+
+import (
+	"context"
+	"time"
+
+	"github.com/opctl/opctl/sdks/go/internal/uniquestring"
+	"github.com/opctl/opctl/sdks/go/model"
+)
+
+// isFinallyCtxKey marks the context of a finally call
+type isFinallyCtxKey struct{}
+
+// detachedCtx carries the values (i.e. secrets) of its parent but not its cancellation
+type detachedCtx struct {
+	context.Context
+}
+
+func (detachedCtx) Deadline() (time.Time, bool) { return time.Time{}, false }
+
+func (detachedCtx) Done() <-chan struct{} { return nil }
+
+func (detachedCtx) Err() error { return nil }
+
+// callFinally calls callSpecFinally once a call has ended w/ callErr.
+// The outcome & error of the call are added to scope as "outcome" & "error"; they're removed from its outputs.
+// The finally call is detached from ctx so it runs even if the call was killed;
+// it's only killed if a kill of the finally call itself is requested.
+func callFinally(
+	ctx context.Context,
+	caller caller,
+	callSpecFinally *model.CallSpec,
+	scope map[string]*model.Value,
+	callErr error,
+	opPath string,
+	parentCallID *string,
+	rootCallID string,
+) (
+	map[string]*model.Value,
+	error,
+) {
+	outcome := model.OpOutcomeSucceeded
+	errMessage := ""
+	if ctx.Err() != nil {
+		outcome = model.OpOutcomeKilled
+	} else if callErr != nil {
+		outcome = model.OpOutcomeFailed
+		errMessage = callErr.Error()
+	}
+
+	finallyScope := map[string]*model.Value{}
+	for varName, varData := range scope {
+		finallyScope[varName] = varData
+	}
+	finallyScope["outcome"] = &model.Value{String: &outcome}
+	finallyScope["error"] = &model.Value{String: &errMessage}
+
+	finallyCallID, err := uniquestring.Construct()
+	if err != nil {
+		return nil, err
+	}
+
+	finallyOutputs, err := caller.Call(
+		context.WithValue(detachedCtx{ctx}, isFinallyCtxKey{}, true),
+		finallyCallID,
+		finallyScope,
+		callSpecFinally,
+		opPath,
+		parentCallID,
+		rootCallID,
+	)
+
+	// outcome & error are only in scope of the finally call; calls returning their scope (i.e. serial)
+	// mustn't leak them to (or let them overwrite outputs of the same name in) the parent's scope
+	delete(finallyOutputs, "outcome")
+	delete(finallyOutputs, "error")
+
+	return finallyOutputs, err
+}
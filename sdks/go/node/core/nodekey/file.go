@@ -0,0 +1,121 @@
+package nodekey
+
+import (
+	"encoding/base64"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+// LoadFile returns a Keyring from the key file at path, creating it w/ a random key if it doesn't exist.
+//
+// Key files hold one base64 encoded key per line; the first is primary.
+func LoadFile(
+	path string,
+) (*Keyring, error) {
+	keys, err := readFile(path)
+	if os.IsNotExist(err) {
+		key, err := generateKey()
+		if err != nil {
+			return nil, err
+		}
+		keys = [][]byte{key}
+
+		if err := writeFile(path, keys); err != nil {
+			return nil, err
+		}
+	} else if err != nil {
+		return nil, err
+	}
+
+	return New(keys...)
+}
+
+// RotateFile adds a random key to the key file at path as its primary key.
+// Prior keys are retained until PruneFile is called.
+func RotateFile(
+	path string,
+) error {
+	keys, err := readFile(path)
+	if err != nil && !os.IsNotExist(err) {
+		return err
+	}
+
+	key, err := generateKey()
+	if err != nil {
+		return err
+	}
+
+	return writeFile(path, append([][]byte{key}, keys...))
+}
+
+// PruneFile removes all but the primary key from the key file at path.
+// Only call once nothing remains encrypted w/ prior keys.
+func PruneFile(
+	path string,
+) error {
+	keys, err := readFile(path)
+	if err != nil {
+		return err
+	}
+
+	if len(keys) < 2 {
+		return nil
+	}
+
+	return writeFile(path, keys[:1])
+}
+
+func readFile(
+	path string,
+) ([][]byte, error) {
+	fileBytes, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	keys := [][]byte{}
+	for _, line := range strings.Split(string(fileBytes), "\n") {
+		line = strings.TrimSpace(line)
+		if line == "" {
+			continue
+		}
+
+		key, err := base64.StdEncoding.DecodeString(line)
+		if err != nil {
+			return nil, errors.Wrapf(err, "invalid node key file '%v'", path)
+		}
+		keys = append(keys, key)
+	}
+
+	if len(keys) == 0 {
+		return nil, errors.Errorf("invalid node key file '%v': no keys", path)
+	}
+
+	return keys, nil
+}
+
+func writeFile(
+	path string,
+	keys [][]byte,
+) error {
+	if err := os.MkdirAll(filepath.Dir(path), 0700); err != nil {
+		return err
+	}
+
+	lines := []string{}
+	for _, key := range keys {
+		lines = append(lines, base64.StdEncoding.EncodeToString(key))
+	}
+
+	// write then rename so the key file is never partially written
+	tmpPath := path + ".tmp"
+	if err := ioutil.WriteFile(tmpPath, []byte(strings.Join(lines, "\n")+"\n"), 0600); err != nil {
+		return err
+	}
+
+	return os.Rename(tmpPath, path)
+}
@@ -0,0 +1,116 @@
+package nodekey
+
+import (
+	"io/ioutil"
+	"path/filepath"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Context("file", func() {
+	var keyFilePath string
+	BeforeEach(func() {
+		dir, err := ioutil.TempDir("", "")
+		if err != nil {
+			panic(err)
+		}
+		keyFilePath = filepath.Join(dir, "node.key")
+	})
+
+	Context("LoadFile", func() {
+		Context("file doesn't exist", func() {
+			It("should create file w/ key", func() {
+				/* arrange/act */
+				_, actualErr := LoadFile(keyFilePath)
+
+				/* assert */
+				Expect(actualErr).To(BeNil())
+
+				actualKeys, err := readFile(keyFilePath)
+				Expect(err).To(BeNil())
+				Expect(actualKeys).To(HaveLen(1))
+			})
+		})
+		Context("file exists", func() {
+			It("should decrypt what it previously encrypted", func() {
+				/* arrange */
+				keyring, err := LoadFile(keyFilePath)
+				if err != nil {
+					panic(err)
+				}
+
+				ciphertext, err := keyring.Encrypt([]byte("plaintext"))
+				if err != nil {
+					panic(err)
+				}
+
+				/* act */
+				objectUnderTest, actualErr := LoadFile(keyFilePath)
+
+				/* assert */
+				Expect(actualErr).To(BeNil())
+
+				actualPlaintext, _, err := objectUnderTest.Decrypt(ciphertext)
+				Expect(err).To(BeNil())
+				Expect(string(actualPlaintext)).To(Equal("plaintext"))
+			})
+		})
+	})
+
+	Context("RotateFile", func() {
+		It("should add new primary key & retain prior keys", func() {
+			/* arrange */
+			priorKeyring, err := LoadFile(keyFilePath)
+			if err != nil {
+				panic(err)
+			}
+
+			ciphertext, err := priorKeyring.Encrypt([]byte("plaintext"))
+			if err != nil {
+				panic(err)
+			}
+
+			/* act */
+			actualErr := RotateFile(keyFilePath)
+
+			/* assert */
+			Expect(actualErr).To(BeNil())
+
+			rotatedKeyring, err := LoadFile(keyFilePath)
+			Expect(err).To(BeNil())
+
+			actualPlaintext, actualIsPrimary, err := rotatedKeyring.Decrypt(ciphertext)
+			Expect(err).To(BeNil())
+			Expect(actualIsPrimary).To(BeFalse())
+			Expect(string(actualPlaintext)).To(Equal("plaintext"))
+		})
+	})
+
+	Context("PruneFile", func() {
+		It("should retain only primary key", func() {
+			/* arrange */
+			if err := RotateFile(keyFilePath); err != nil {
+				panic(err)
+			}
+			if err := RotateFile(keyFilePath); err != nil {
+				panic(err)
+			}
+
+			expectedKeys, err := readFile(keyFilePath)
+			if err != nil {
+				panic(err)
+			}
+
+			/* act */
+			actualErr := PruneFile(keyFilePath)
+
+			/* assert */
+			Expect(actualErr).To(BeNil())
+
+			actualKeys, err := readFile(keyFilePath)
+			Expect(err).To(BeNil())
+			Expect(actualKeys).To(Equal(expectedKeys[:1]))
+		})
+	})
+})
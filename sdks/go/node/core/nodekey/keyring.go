@@ -0,0 +1,90 @@
+// Package nodekey exports a Keyring used by a node to encrypt data at rest
+package nodekey
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"errors"
+	"fmt"
+	"io"
+)
+
+// KeySize is the size in bytes of node keys (AES-256)
+const KeySize = 32
+
+// Keyring encrypts w/ its primary (first) key & decrypts w/ any of its keys.
+// Keys prior to the primary are retained so data encrypted before a rotation remains readable.
+type Keyring struct {
+	aeads []cipher.AEAD
+}
+
+// New returns a Keyring from keys; keys[0] is primary
+func New(
+	keys ...[]byte,
+) (*Keyring, error) {
+	keyring := &Keyring{}
+	for _, key := range keys {
+		if len(key) != KeySize {
+			return nil, fmt.Errorf("invalid node key: must be %v bytes", KeySize)
+		}
+
+		block, err := aes.NewCipher(key)
+		if err != nil {
+			return nil, err
+		}
+
+		aead, err := cipher.NewGCM(block)
+		if err != nil {
+			return nil, err
+		}
+
+		keyring.aeads = append(keyring.aeads, aead)
+	}
+
+	return keyring, nil
+}
+
+// Encrypt plaintext w/ the primary key; the result is prefixed w/ its nonce
+func (k Keyring) Encrypt(
+	plaintext []byte,
+) ([]byte, error) {
+	if len(k.aeads) == 0 {
+		return nil, errors.New("unable to encrypt: keyring empty")
+	}
+
+	aead := k.aeads[0]
+	nonce := make([]byte, aead.NonceSize())
+	if _, err := io.ReadFull(rand.Reader, nonce); err != nil {
+		return nil, err
+	}
+
+	return aead.Seal(nonce, nonce, plaintext, nil), nil
+}
+
+// Decrypt ciphertext w/ the first key able to; isPrimary reports whether that key was the primary key
+func (k Keyring) Decrypt(
+	ciphertext []byte,
+) (plaintext []byte, isPrimary bool, err error) {
+	for i, aead := range k.aeads {
+		if len(ciphertext) < aead.NonceSize() {
+			break
+		}
+
+		nonce, sealed := ciphertext[:aead.NonceSize()], ciphertext[aead.NonceSize():]
+		if plaintext, err := aead.Open(nil, nonce, sealed, nil); err == nil {
+			return plaintext, i == 0, nil
+		}
+	}
+
+	return nil, false, errors.New("unable to decrypt: no key in keyring matches")
+}
+
+// generateKey returns a random key
+func generateKey() ([]byte, error) {
+	key := make([]byte, KeySize)
+	if _, err := io.ReadFull(rand.Reader, key); err != nil {
+		return nil, err
+	}
+	return key, nil
+}
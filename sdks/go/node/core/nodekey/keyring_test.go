@@ -0,0 +1,128 @@
+package nodekey
+
+import (
+	"bytes"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Context("Keyring", func() {
+	primaryKey := bytes.Repeat([]byte{1}, KeySize)
+	priorKey := bytes.Repeat([]byte{2}, KeySize)
+
+	Context("New", func() {
+		Context("key wrong size", func() {
+			It("should return expected error", func() {
+				/* arrange/act */
+				_, actualErr := New([]byte("short"))
+
+				/* assert */
+				Expect(actualErr).To(MatchError("invalid node key: must be 32 bytes"))
+			})
+		})
+	})
+
+	Context("Encrypt", func() {
+		Context("keyring empty", func() {
+			It("should return expected error", func() {
+				/* arrange/act */
+				_, actualErr := Keyring{}.Encrypt([]byte("plaintext"))
+
+				/* assert */
+				Expect(actualErr).To(MatchError("unable to encrypt: keyring empty"))
+			})
+		})
+		It("should not contain plaintext", func() {
+			/* arrange */
+			objectUnderTest, err := New(primaryKey)
+			if err != nil {
+				panic(err)
+			}
+
+			/* act */
+			actualCiphertext, actualErr := objectUnderTest.Encrypt([]byte("plaintext"))
+
+			/* assert */
+			Expect(actualErr).To(BeNil())
+			Expect(string(actualCiphertext)).NotTo(ContainSubstring("plaintext"))
+		})
+	})
+
+	Context("Decrypt", func() {
+		Context("encrypted w/ primary key", func() {
+			It("should return expected result", func() {
+				/* arrange */
+				objectUnderTest, err := New(primaryKey, priorKey)
+				if err != nil {
+					panic(err)
+				}
+
+				ciphertext, err := objectUnderTest.Encrypt([]byte("plaintext"))
+				if err != nil {
+					panic(err)
+				}
+
+				/* act */
+				actualPlaintext, actualIsPrimary, actualErr := objectUnderTest.Decrypt(ciphertext)
+
+				/* assert */
+				Expect(actualErr).To(BeNil())
+				Expect(actualIsPrimary).To(BeTrue())
+				Expect(string(actualPlaintext)).To(Equal("plaintext"))
+			})
+		})
+		Context("encrypted w/ prior key", func() {
+			It("should return expected result", func() {
+				/* arrange */
+				priorKeyring, err := New(priorKey)
+				if err != nil {
+					panic(err)
+				}
+
+				ciphertext, err := priorKeyring.Encrypt([]byte("plaintext"))
+				if err != nil {
+					panic(err)
+				}
+
+				objectUnderTest, err := New(primaryKey, priorKey)
+				if err != nil {
+					panic(err)
+				}
+
+				/* act */
+				actualPlaintext, actualIsPrimary, actualErr := objectUnderTest.Decrypt(ciphertext)
+
+				/* assert */
+				Expect(actualErr).To(BeNil())
+				Expect(actualIsPrimary).To(BeFalse())
+				Expect(string(actualPlaintext)).To(Equal("plaintext"))
+			})
+		})
+		Context("encrypted w/ unknown key", func() {
+			It("should return expected error", func() {
+				/* arrange */
+				otherKeyring, err := New(priorKey)
+				if err != nil {
+					panic(err)
+				}
+
+				ciphertext, err := otherKeyring.Encrypt([]byte("plaintext"))
+				if err != nil {
+					panic(err)
+				}
+
+				objectUnderTest, err := New(primaryKey)
+				if err != nil {
+					panic(err)
+				}
+
+				/* act */
+				_, _, actualErr := objectUnderTest.Decrypt(ciphertext)
+
+				/* assert */
+				Expect(actualErr).To(MatchError("unable to decrypt: no key in keyring matches"))
+			})
+		})
+	})
+})
@@ -0,0 +1,52 @@
+package nodekey
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+
+	"golang.org/x/crypto/scrypt"
+)
+
+// scrypt cost params; see https://pkg.go.dev/golang.org/x/crypto/scrypt#Key
+const (
+	scryptN = 32768
+	scryptR = 8
+	scryptP = 1
+)
+
+// FromPassphrases returns a Keyring w/ keys derived from passphrases; passphrases[0] is primary.
+//
+// Keys are salted w/ the salt file at saltPath, which is created w/ a random salt if it doesn't exist.
+func FromPassphrases(
+	saltPath string,
+	passphrases ...string,
+) (*Keyring, error) {
+	salt, err := ioutil.ReadFile(saltPath)
+	if os.IsNotExist(err) {
+		if salt, err = generateKey(); err != nil {
+			return nil, err
+		}
+
+		if err := os.MkdirAll(filepath.Dir(saltPath), 0700); err != nil {
+			return nil, err
+		}
+
+		if err := ioutil.WriteFile(saltPath, salt, 0600); err != nil {
+			return nil, err
+		}
+	} else if err != nil {
+		return nil, err
+	}
+
+	keys := [][]byte{}
+	for _, passphrase := range passphrases {
+		key, err := scrypt.Key([]byte(passphrase), salt, scryptN, scryptR, scryptP, KeySize)
+		if err != nil {
+			return nil, err
+		}
+		keys = append(keys, key)
+	}
+
+	return New(keys...)
+}
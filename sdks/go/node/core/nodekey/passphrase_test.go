@@ -0,0 +1,41 @@
+package nodekey
+
+import (
+	"io/ioutil"
+	"path/filepath"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Context("FromPassphrases", func() {
+	It("should derive same keys from same passphrases & salt", func() {
+		/* arrange */
+		dir, err := ioutil.TempDir("", "")
+		if err != nil {
+			panic(err)
+		}
+		saltPath := filepath.Join(dir, "node.salt")
+
+		priorKeyring, err := FromPassphrases(saltPath, "prior")
+		if err != nil {
+			panic(err)
+		}
+
+		ciphertext, err := priorKeyring.Encrypt([]byte("plaintext"))
+		if err != nil {
+			panic(err)
+		}
+
+		/* act */
+		objectUnderTest, actualErr := FromPassphrases(saltPath, "primary", "prior")
+
+		/* assert */
+		Expect(actualErr).To(BeNil())
+
+		actualPlaintext, actualIsPrimary, err := objectUnderTest.Decrypt(ciphertext)
+		Expect(err).To(BeNil())
+		Expect(actualIsPrimary).To(BeFalse())
+		Expect(string(actualPlaintext)).To(Equal("plaintext"))
+	})
+})
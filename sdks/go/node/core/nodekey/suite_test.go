@@ -0,0 +1,13 @@
+package nodekey
+
+import (
+	"testing"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+func TestNodeKey(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "node/core/nodekey")
+}
@@ -85,15 +85,49 @@ func (oc _opCaller) Call(
 		&opCall.OpID,
 		rootCallID,
 	)
-	if err != nil {
-		return outboundScope, err
-	}
 
-	var opFile *model.OpSpec
-	opFile, err = opfile.Get(
+	opFile, getErr := opfile.Get(
 		ctx,
 		opCall.OpPath,
 	)
+	if getErr != nil {
+		if err == nil {
+			err = getErr
+		}
+		return outboundScope, err
+	}
+
+	if opFile.Finally != nil {
+		finallyScope := map[string]*model.Value{}
+		for varName, varData := range opCallScope {
+			finallyScope[varName] = varData
+		}
+		for varName, varData := range opOutputs {
+			finallyScope[varName] = varData
+		}
+
+		finallyOutputs, finallyErr := callFinally(
+			ctx,
+			oc.caller,
+			opFile.Finally,
+			finallyScope,
+			err,
+			opCall.OpPath,
+			&opCall.OpID,
+			rootCallID,
+		)
+		if err == nil {
+			// finally failing fails the op unless it already failed
+			err = finallyErr
+		}
+		if opOutputs == nil {
+			opOutputs = map[string]*model.Value{}
+		}
+		for varName, varData := range finallyOutputs {
+			opOutputs[varName] = varData
+		}
+	}
+
 	if err != nil {
 		return outboundScope, err
 	}
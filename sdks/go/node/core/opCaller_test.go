@@ -2,6 +2,7 @@ package core
 
 import (
 	"context"
+	"errors"
 
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
@@ -148,5 +149,43 @@ var _ = Context("opCaller", func() {
 			Expect(actualErr).To(BeNil())
 			Expect(actualOutputs).To(Equal(expectedOutputs))
 		})
+		Context("op has finally & child call fails", func() {
+			It("should call finally w/ outcome & error in scope & return child err", func() {
+				/* arrange */
+				providedOpCall := &model.OpCall{
+					BaseCall: model.BaseCall{
+						OpPath: "testdata/opCallerFinally",
+					},
+					OpID: "providedOpId",
+				}
+
+				fakeCaller := new(FakeCaller)
+				fakeCaller.CallReturnsOnCall(0, nil, errors.New("childErr"))
+
+				objectUnderTest := _opCaller{
+					caller: fakeCaller,
+				}
+
+				/* act */
+				_, actualErr := objectUnderTest.Call(
+					context.Background(),
+					providedOpCall,
+					map[string]*model.Value{},
+					nil,
+					"rootCallID",
+					&model.OpCallSpec{},
+				)
+
+				/* assert */
+				Expect(actualErr).To(MatchError("childErr"))
+				Expect(fakeCaller.CallCallCount()).To(Equal(2))
+
+				_, _, actualFinallyScope, actualFinallyCallSpec, _, actualParentCallID, _ := fakeCaller.CallArgsForCall(1)
+				Expect(*actualFinallyScope["outcome"].String).To(Equal(model.OpOutcomeFailed))
+				Expect(*actualFinallyScope["error"].String).To(Equal("childErr"))
+				Expect(actualFinallyCallSpec.Serial).To(Not(BeNil()))
+				Expect(*actualParentCallID).To(Equal(providedOpCall.OpID))
+			})
+		})
 	})
 })
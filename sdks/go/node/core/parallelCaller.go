@@ -2,15 +2,16 @@ package core
 
 import (
 	"context"
-	"errors"
 	"fmt"
 	"runtime/debug"
 	"time"
 
+	aggregateError "github.com/opctl/opctl/sdks/go/internal/aggregate_error"
 	"github.com/opctl/opctl/sdks/go/internal/uniquestring"
 	"github.com/opctl/opctl/sdks/go/model"
 	"github.com/opctl/opctl/sdks/go/opspec"
 	"github.com/opctl/opctl/sdks/go/pubsub"
+	"github.com/pkg/errors"
 )
 
 //counterfeiter:generate -o internal/fakes/parallelCaller.go . parallelCaller
@@ -23,6 +24,7 @@ type parallelCaller interface {
 		rootCallID string,
 		opPath string,
 		callSpecParallelCall []*model.CallSpec,
+		isFailFast bool,
 	) (
 		map[string]*model.Value,
 		error,
@@ -53,6 +55,7 @@ func (pc _parallelCaller) Call(
 	rootCallID string,
 	opPath string,
 	callSpecParallelCall []*model.CallSpec,
+	isFailFast bool,
 ) (
 	map[string]*model.Value,
 	error,
@@ -73,6 +76,7 @@ func (pc _parallelCaller) Call(
 	childCallIndexByID := map[string]int{}
 	childCallIDByName := map[string]string{}
 	childCallOutputsByIndex := map[int]map[string]*model.Value{}
+	childCallOutputs := newChildOutputs()
 
 	// perform calls in parallel w/ cancellation
 	for childCallIndex, childCall := range callSpecParallelCall {
@@ -90,6 +94,10 @@ func (pc _parallelCaller) Call(
 		}
 
 		go func(childCall *model.CallSpec) {
+			var outputs map[string]*model.Value
+			defer func() {
+				childCallOutputs.Set(childCallID, outputs)
+			}()
 			defer func() {
 				if panicArg := recover(); panicArg != nil {
 					// recover from panics; treat as errors
@@ -100,7 +108,7 @@ func (pc _parallelCaller) Call(
 				}
 			}()
 
-			pc.caller.Call(
+			outputs, _ = pc.caller.Call(
 				parallelCtx,
 				childCallID,
 				inboundScope,
@@ -124,19 +132,22 @@ func (pc _parallelCaller) Call(
 		},
 	)
 
-	var isChildErred = false
+	childErrsByIndex := map[int]error{}
 	outputs := map[string]*model.Value{}
 
 eventLoop:
 	for event := range eventChannel {
 		if event.CallEnded != nil {
 			if childCallIndex, isChildCallEnded := childCallIndexByID[event.CallEnded.Call.ID]; isChildCallEnded {
-				childCallOutputsByIndex[childCallIndex] = event.CallEnded.Outputs
+				// outputs of CallEnded events are redacted so take them from the call
+				childCallOutputsByIndex[childCallIndex] = childCallOutputs.Get(event.CallEnded.Call.ID)
 				if event.CallEnded.Error != nil {
-					isChildErred = true
+					childErrsByIndex[childCallIndex] = errors.New(event.CallEnded.Error.Message)
 
-					// cancel all children on any error
-					cancelParallel()
+					if isFailFast {
+						// cancel all children on any error
+						cancelParallel()
+					}
 				}
 
 				// decrement needed by counts for any needs
@@ -174,8 +185,18 @@ eventLoop:
 					}
 				}
 
-				if isChildErred {
-					return nil, errors.New("child call failed")
+				if len(childErrsByIndex) > 0 {
+					if isFailFast {
+						return nil, errors.New("child call failed")
+					}
+
+					var agg aggregateError.ErrAggregate
+					for i, childCall := range callSpecParallelCall {
+						if childErr, ok := childErrsByIndex[i]; ok {
+							agg.AddError(errors.Wrap(childErr, childCallLabel(i, childCall)))
+						}
+					}
+					return nil, errors.Wrap(agg, "child calls failed")
 				}
 
 				break eventLoop
@@ -186,3 +207,14 @@ eventLoop:
 
 	return outputs, nil
 }
+
+// childCallLabel labels a child call by name if it has one, otherwise by index
+func childCallLabel(
+	childCallIndex int,
+	childCall *model.CallSpec,
+) string {
+	if childCall.Name != nil {
+		return fmt.Sprintf("child call '%v'", *childCall.Name)
+	}
+	return fmt.Sprintf("child call %v", childCallIndex)
+}
@@ -13,6 +13,7 @@ import (
 	"github.com/opctl/opctl/sdks/go/model"
 	containerRuntimeFakes "github.com/opctl/opctl/sdks/go/node/core/containerruntime/fakes"
 	. "github.com/opctl/opctl/sdks/go/node/core/internal/fakes"
+	"github.com/opctl/opctl/sdks/go/node/core/nodekey"
 	"github.com/opctl/opctl/sdks/go/pubsub"
 	. "github.com/opctl/opctl/sdks/go/pubsub/fakes"
 )
@@ -51,9 +52,10 @@ var _ = Context("parallelCaller", func() {
 						newContainerCaller(
 							new(containerRuntimeFakes.FakeContainerRuntime),
 							pubSub,
-							newStateStore(
+							mustNewStateStore(
 								context.Background(),
 								db,
+								new(nodekey.Keyring),
 								pubSub,
 							),
 						),
@@ -76,6 +78,7 @@ var _ = Context("parallelCaller", func() {
 							Container: &model.ContainerCallSpec{},
 						},
 					},
+					true,
 				)
 
 				/* assert */
@@ -83,6 +86,71 @@ var _ = Context("parallelCaller", func() {
 			})
 		})
 
+		Context("isFailFast false & callers error", func() {
+
+			It("should return aggregated errors of all children", func() {
+				/* arrange */
+				dbDir, err := ioutil.TempDir("", "")
+				if err != nil {
+					panic(err)
+				}
+
+				db, err := badger.Open(
+					badger.DefaultOptions(dbDir).WithLogger(nil),
+				)
+				if err != nil {
+					panic(err)
+				}
+				pubSub := pubsub.New(db)
+
+				objectUnderTest := _parallelCaller{
+					caller: newCaller(
+						newContainerCaller(
+							new(containerRuntimeFakes.FakeContainerRuntime),
+							pubSub,
+							mustNewStateStore(
+								context.Background(),
+								db,
+								new(nodekey.Keyring),
+								pubSub,
+							),
+						),
+						dbDir,
+						pubSub,
+					),
+					pubSub: pubSub,
+				}
+
+				providedName := "providedName"
+
+				/* act */
+				_, actualErr := objectUnderTest.Call(
+					context.Background(),
+					"callID",
+					map[string]*model.Value{},
+					"rootCallID",
+					"opPath",
+					[]*model.CallSpec{
+						{
+							// intentionally invalid
+							Container: &model.ContainerCallSpec{},
+							Name:      &providedName,
+						},
+						{
+							// intentionally invalid
+							Container: &model.ContainerCallSpec{},
+						},
+					},
+					false,
+				)
+
+				/* assert */
+				Expect(actualErr.Error()).To(HavePrefix("child calls failed: "))
+				Expect(actualErr.Error()).To(ContainSubstring("- child call 'providedName': "))
+				Expect(actualErr.Error()).To(ContainSubstring("- child call 1: "))
+			})
+		})
+
 		It("should start each child as expected", func() {
 
 			/* arrange */
@@ -147,9 +215,10 @@ var _ = Context("parallelCaller", func() {
 					newContainerCaller(
 						fakeContainerRuntime,
 						pubSub,
-						newStateStore(
+						mustNewStateStore(
 							ctx,
 							db,
+							new(nodekey.Keyring),
 							pubSub,
 						),
 					),
@@ -184,6 +253,7 @@ var _ = Context("parallelCaller", func() {
 						},
 					},
 				},
+				true,
 			)
 
 			/* assert */
@@ -215,6 +285,7 @@ var _ = Context("parallelCaller", func() {
 									BaseCall: model.BaseCall{
 										OpPath: childOp1Path,
 									},
+									Ref:               childOp1Path,
 									Inputs:            providedInboundScope,
 									ChildCallCallSpec: nil,
 								},
@@ -229,6 +300,7 @@ var _ = Context("parallelCaller", func() {
 									BaseCall: model.BaseCall{
 										OpPath: childOp2Path,
 									},
+									Ref:               childOp2Path,
 									Inputs:            providedInboundScope,
 									ChildCallCallSpec: nil,
 								},
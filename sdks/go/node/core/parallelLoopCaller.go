@@ -2,7 +2,6 @@ package core
 
 import (
 	"context"
-	"errors"
 	"fmt"
 	"runtime/debug"
 	"time"
@@ -11,9 +10,11 @@ import (
 	"github.com/opctl/opctl/sdks/go/opspec/interpreter/call/loop/iteration"
 	"github.com/opctl/opctl/sdks/go/opspec/interpreter/call/parallelloop"
 
+	aggregateError "github.com/opctl/opctl/sdks/go/internal/aggregate_error"
 	"github.com/opctl/opctl/sdks/go/internal/uniquestring"
 	"github.com/opctl/opctl/sdks/go/model"
 	"github.com/opctl/opctl/sdks/go/pubsub"
+	"github.com/pkg/errors"
 )
 
 //counterfeiter:generate -o internal/fakes/parallelLoopCaller.go . parallelLoopCaller
@@ -64,22 +65,27 @@ func (plpr _parallelLoopCaller) Call(
 	parallelLoopCtx, cancelParallelLoop := context.WithCancel(parentCtx)
 	defer cancelParallelLoop()
 
-	childCallIndex := 0
 	startTime := time.Now().UTC()
-	childCallIndexByID := map[string]int{}
 
-	for {
+	loopRangeSpec, err := parallelloop.RangeSpec(
+		callSpecParallelLoop,
+		inboundScope,
+	)
+	if err != nil {
+		return nil, err
+	}
 
-		childCallID, err := uniquestring.Construct()
-		if err != nil {
-			// end run immediately on any error
-			return nil, err
-		}
+	// interpret every iteration up front so iterations can be started as concurrency allows
+	var maxConcurrency *int
+	childCallIDs := []string{}
+	childCallScopes := []map[string]*model.Value{}
+
+	for childCallIndex := 0; ; childCallIndex++ {
 
 		childCallScope, scopeErr := iteration.Scope(
 			childCallIndex,
 			inboundScope,
-			callSpecParallelLoop.Range,
+			loopRangeSpec,
 			callSpecParallelLoop.Vars,
 		)
 		if scopeErr != nil {
@@ -99,9 +105,56 @@ func (plpr _parallelLoopCaller) Call(
 			break
 		}
 
+		maxConcurrency = callParallelLoop.MaxConcurrency
+
+		childCallID, err := uniquestring.Construct()
+		if err != nil {
+			// end run immediately on any error
+			return nil, err
+		}
+
+		childCallIDs = append(childCallIDs, childCallID)
+		childCallScopes = append(childCallScopes, childCallScope)
+	}
+
+	if len(childCallIDs) == 0 {
+		if len(callSpecParallelLoop.Collect) == 0 {
+			return nil, nil
+		}
+		// collect empty outputs
+		return loop.Collect(
+			inboundScope,
+			callSpecParallelLoop.Collect,
+			loopRangeSpec,
+			nil,
+		)
+	}
+
+	// subscribe to events
+	// @TODO: handle err channel
+	eventChannel, _ := plpr.pubSub.Subscribe(
+		// don't cancel w/ children; we need to read err msgs
+		parentCtx,
+		model.EventFilter{
+			Roots: []string{rootCallID},
+			Since: &startTime,
+		},
+	)
+
+	childCallIndexByID := map[string]int{}
+	childCallOutputs := newChildOutputs()
+
+	// startNextChildCall starts the next not yet started iteration
+	startNextChildCall := func() {
+		childCallIndex := len(childCallIndexByID)
+		childCallID := childCallIDs[childCallIndex]
 		childCallIndexByID[childCallID] = childCallIndex
 
 		go func() {
+			var outputs map[string]*model.Value
+			defer func() {
+				childCallOutputs.Set(childCallID, outputs)
+			}()
 			defer func() {
 				if panicArg := recover(); panicArg != nil {
 					// recover from panics; treat as errors
@@ -112,37 +165,25 @@ func (plpr _parallelLoopCaller) Call(
 				}
 			}()
 
-			plpr.caller.Call(
+			outputs, _ = plpr.caller.Call(
 				parallelLoopCtx,
 				childCallID,
-				childCallScope,
+				childCallScopes[childCallIndex],
 				&callSpecParallelLoop.Run,
 				opPath,
 				parentCallID,
 				rootCallID,
 			)
 		}()
-
-		childCallIndex++
-
 	}
 
-	if len(childCallIndexByID) == 0 {
-		return nil, nil
+	for len(childCallIndexByID) < len(childCallIDs) &&
+		(maxConcurrency == nil || len(childCallIndexByID) < *maxConcurrency) {
+		startNextChildCall()
 	}
 
-	// subscribe to events
-	// @TODO: handle err channel
-	eventChannel, _ := plpr.pubSub.Subscribe(
-		// don't cancel w/ children; we need to read err msgs
-		parentCtx,
-		model.EventFilter{
-			Roots: []string{rootCallID},
-			Since: &startTime,
-		},
-	)
-
-	var isChildErred = false
+	isFailFast := callSpecParallelLoop.FailFast == nil || *callSpecParallelLoop.FailFast
+	childErrsByIndex := map[int]error{}
 	childCallOutputsByIndex := map[int]map[string]*model.Value{}
 	outputs := inboundScope
 
@@ -150,17 +191,24 @@ eventLoop:
 	for event := range eventChannel {
 		if event.CallEnded != nil {
 			if childCallIndex, isChildCallEnded := childCallIndexByID[event.CallEnded.Call.ID]; isChildCallEnded {
-				childCallOutputsByIndex[childCallIndex] = event.CallEnded.Outputs
+				// outputs of CallEnded events are redacted so take them from the call
+				childCallOutputsByIndex[childCallIndex] = childCallOutputs.Get(event.CallEnded.Call.ID)
 				if event.CallEnded.Error != nil {
-					isChildErred = true
+					childErrsByIndex[childCallIndex] = errors.New(event.CallEnded.Error.Message)
 
-					// cancel all children on any error
-					cancelParallelLoop()
+					if isFailFast {
+						// cancel all children on any error
+						cancelParallelLoop()
+					}
+				}
+				if (!isFailFast || len(childErrsByIndex) == 0) && len(childCallIndexByID) < len(childCallIDs) {
+					// a slot freed up; start the next iteration
+					startNextChildCall()
 				}
 			}
 
 			if len(childCallOutputsByIndex) == len(childCallIndexByID) {
-				// all calls have ended
+				// all started calls have ended; when failing fast, iterations aren't started after an error
 
 				// construct parallel outputs
 				for i := 0; i < len(childCallIndexByID); i++ {
@@ -170,8 +218,18 @@ eventLoop:
 					}
 				}
 
-				if isChildErred {
-					return nil, errors.New("child call failed")
+				if len(childErrsByIndex) > 0 {
+					if isFailFast {
+						return nil, errors.New("child call failed")
+					}
+
+					var agg aggregateError.ErrAggregate
+					for i := 0; i < len(childCallIndexByID); i++ {
+						if childErr, ok := childErrsByIndex[i]; ok {
+							agg.AddError(errors.Wrap(childErr, fmt.Sprintf("iteration %v", i)))
+						}
+					}
+					return nil, errors.Wrap(agg, "child calls failed")
 				}
 
 				break eventLoop
@@ -180,10 +238,20 @@ eventLoop:
 		}
 	}
 
-	return loop.DeScope(
-		inboundScope,
-		callSpecParallelLoop.Range,
-		callSpecParallelLoop.Vars,
-		outputs,
-	), nil
+	iterationOutputs := []map[string]*model.Value{}
+	for i := 0; i < len(childCallIDs); i++ {
+		iterationOutputs = append(iterationOutputs, childCallOutputsByIndex[i])
+	}
+
+	return loop.Collect(
+		loop.DeScope(
+			inboundScope,
+			loopRangeSpec,
+			callSpecParallelLoop.Vars,
+			outputs,
+		),
+		callSpecParallelLoop.Collect,
+		loopRangeSpec,
+		iterationOutputs,
+	)
 }
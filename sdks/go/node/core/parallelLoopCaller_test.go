@@ -4,6 +4,8 @@ import (
 	"context"
 	"io"
 	"io/ioutil"
+	"sync"
+	"time"
 
 	"github.com/dgraph-io/badger/v2"
 	. "github.com/onsi/ginkgo"
@@ -11,6 +13,7 @@ import (
 	"github.com/opctl/opctl/sdks/go/model"
 	containerRuntimeFakes "github.com/opctl/opctl/sdks/go/node/core/containerruntime/fakes"
 	. "github.com/opctl/opctl/sdks/go/node/core/internal/fakes"
+	"github.com/opctl/opctl/sdks/go/node/core/nodekey"
 	"github.com/opctl/opctl/sdks/go/pubsub"
 	. "github.com/opctl/opctl/sdks/go/pubsub/fakes"
 )
@@ -79,9 +82,10 @@ var _ = Context("parallelLoopCaller", func() {
 					newContainerCaller(
 						new(containerRuntimeFakes.FakeContainerRuntime),
 						pubSub,
-						newStateStore(
+						mustNewStateStore(
 							providedCtx,
 							db,
+							new(nodekey.Keyring),
 							pubSub,
 						),
 					),
@@ -118,6 +122,234 @@ var _ = Context("parallelLoopCaller", func() {
 			})
 		})
 
+		Context("failFast false & iterations fail", func() {
+			It("should return aggregated errors of all iterations", func() {
+				/* arrange */
+				dbDir, err := ioutil.TempDir("", "")
+				if err != nil {
+					panic(err)
+				}
+
+				db, err := badger.Open(
+					badger.DefaultOptions(dbDir).WithLogger(nil),
+				)
+				if err != nil {
+					panic(err)
+				}
+				pubSub := pubsub.New(db)
+
+				providedCtx := context.Background()
+				failFast := false
+
+				objectUnderTest := _parallelLoopCaller{
+					caller: newCaller(
+						newContainerCaller(
+							new(containerRuntimeFakes.FakeContainerRuntime),
+							pubSub,
+							mustNewStateStore(
+								providedCtx,
+								db,
+								new(nodekey.Keyring),
+								pubSub,
+							),
+						),
+						dbDir,
+						pubSub,
+					),
+					pubSub: pubSub,
+				}
+
+				/* act */
+				_, actualErr := objectUnderTest.Call(
+					providedCtx,
+					"id",
+					map[string]*model.Value{},
+					model.ParallelLoopCallSpec{
+						FailFast: &failFast,
+						Range:    []interface{}{0, 1, 2},
+						Run: model.CallSpec{
+							// intentionally invalid
+							Container: &model.ContainerCallSpec{},
+						},
+					},
+					"opPath",
+					new(string),
+					"rootCallID",
+				)
+
+				/* assert */
+				Expect(actualErr.Error()).To(HavePrefix("child calls failed: "))
+				Expect(actualErr.Error()).To(ContainSubstring("- iteration 0: "))
+				Expect(actualErr.Error()).To(ContainSubstring("- iteration 2: "))
+			})
+		})
+
+		Context("maxConcurrency set", func() {
+			It("should have at most maxConcurrency children in flight", func() {
+				/* arrange */
+				dbDir, err := ioutil.TempDir("", "")
+				if err != nil {
+					panic(err)
+				}
+
+				db, err := badger.Open(
+					badger.DefaultOptions(dbDir).WithLogger(nil),
+				)
+				if err != nil {
+					panic(err)
+				}
+				pubSub := pubsub.New(db)
+
+				providedRootID := "providedRootID"
+
+				var inFlightMutex sync.Mutex
+				inFlight := 0
+				maxInFlight := 0
+
+				fakeCaller := new(FakeCaller)
+				fakeCaller.CallStub = func(
+					ctx context.Context,
+					id string,
+					scope map[string]*model.Value,
+					callSpec *model.CallSpec,
+					opPath string,
+					parentCallID *string,
+					rootCallID string,
+				) (map[string]*model.Value, error) {
+					inFlightMutex.Lock()
+					inFlight++
+					if inFlight > maxInFlight {
+						maxInFlight = inFlight
+					}
+					inFlightMutex.Unlock()
+
+					time.Sleep(10 * time.Millisecond)
+
+					inFlightMutex.Lock()
+					inFlight--
+					inFlightMutex.Unlock()
+
+					pubSub.Publish(
+						model.Event{
+							CallEnded: &model.CallEnded{
+								Call: model.Call{
+									ID:     id,
+									RootID: rootCallID,
+								},
+							},
+							Timestamp: time.Now().UTC(),
+						},
+					)
+
+					return nil, nil
+				}
+
+				objectUnderTest := _parallelLoopCaller{
+					caller: fakeCaller,
+					pubSub: pubSub,
+				}
+
+				/* act */
+				_, actualErr := objectUnderTest.Call(
+					context.Background(),
+					"id",
+					map[string]*model.Value{},
+					model.ParallelLoopCallSpec{
+						MaxConcurrency: 2,
+						Range:          []interface{}{0, 1, 2, 3, 4},
+					},
+					"opPath",
+					nil,
+					providedRootID,
+				)
+
+				/* assert */
+				Expect(actualErr).To(BeNil())
+				Expect(fakeCaller.CallCallCount()).To(Equal(5))
+				Expect(maxInFlight).To(Equal(2))
+			})
+		})
+
+		Context("collect set", func() {
+			It("should return outputs of every iteration in iteration order", func() {
+				/* arrange */
+				dbDir, err := ioutil.TempDir("", "")
+				if err != nil {
+					panic(err)
+				}
+
+				db, err := badger.Open(
+					badger.DefaultOptions(dbDir).WithLogger(nil),
+				)
+				if err != nil {
+					panic(err)
+				}
+				pubSub := pubsub.New(db)
+
+				providedRootID := "providedRootID"
+				indexName := "index"
+
+				fakeCaller := new(FakeCaller)
+				fakeCaller.CallStub = func(
+					ctx context.Context,
+					id string,
+					scope map[string]*model.Value,
+					callSpec *model.CallSpec,
+					opPath string,
+					parentCallID *string,
+					rootCallID string,
+				) (map[string]*model.Value, error) {
+					// finish later iterations first to ensure order is by iteration
+					time.Sleep(time.Duration(3-*scope[indexName].Number) * 10 * time.Millisecond)
+
+					outputs := map[string]*model.Value{
+						"digest": scope[indexName],
+					}
+
+					pubSub.Publish(
+						model.Event{
+							CallEnded: &model.CallEnded{
+								Call: model.Call{
+									ID:     id,
+									RootID: rootCallID,
+								},
+								Outputs: outputs,
+							},
+							Timestamp: time.Now().UTC(),
+						},
+					)
+
+					return outputs, nil
+				}
+
+				objectUnderTest := _parallelLoopCaller{
+					caller: fakeCaller,
+					pubSub: pubSub,
+				}
+
+				/* act */
+				actualOutputs, actualErr := objectUnderTest.Call(
+					context.Background(),
+					"id",
+					map[string]*model.Value{},
+					model.ParallelLoopCallSpec{
+						Collect: []string{"$(digest)"},
+						Range:   []interface{}{"a", "b", "c"},
+						Vars: &model.LoopVarsSpec{
+							Index: &indexName,
+						},
+					},
+					"opPath",
+					nil,
+					providedRootID,
+				)
+
+				/* assert */
+				Expect(actualErr).To(BeNil())
+				Expect(actualOutputs["digest"]).To(Equal(&model.Value{Array: &[]interface{}{0.0, 1.0, 2.0}}))
+			})
+		})
+
 		It("should start each child as expected", func() {
 			/* arrange */
 			dbDir, err := ioutil.TempDir("", "")
@@ -169,9 +401,10 @@ var _ = Context("parallelLoopCaller", func() {
 					newContainerCaller(
 						fakeContainerRuntime,
 						pubSub,
-						newStateStore(
+						mustNewStateStore(
 							ctx,
 							db,
+							new(nodekey.Keyring),
 							pubSub,
 						),
 					),
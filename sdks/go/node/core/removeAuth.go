@@ -0,0 +1,40 @@
+package core
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/opctl/opctl/sdks/go/model"
+)
+
+func (this core) RemoveAuth(
+	ctx context.Context,
+	req model.RemoveAuthReq,
+) error {
+	isFound := false
+	for _, auth := range this.stateStore.ListAuths() {
+		if strings.EqualFold(auth.Resources, req.Resources) {
+			isFound = true
+			break
+		}
+	}
+	if !isFound {
+		return fmt.Errorf("auth for '%v' not found", req.Resources)
+	}
+
+	if err := this.stateStore.RemoveAuth(req.Resources); err != nil {
+		return err
+	}
+
+	this.pubSub.Publish(
+		model.Event{
+			AuthRemoved: &model.AuthRemoved{
+				Resources: req.Resources,
+			},
+			Timestamp: time.Now().UTC(),
+		},
+	)
+	return nil
+}
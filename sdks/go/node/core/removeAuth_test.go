@@ -0,0 +1,189 @@
+package core
+
+import (
+	"bytes"
+	"context"
+	"io/ioutil"
+	"time"
+
+	"github.com/dgraph-io/badger/v2"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/opctl/opctl/sdks/go/model"
+	"github.com/opctl/opctl/sdks/go/node/core/nodekey"
+	"github.com/opctl/opctl/sdks/go/pubsub"
+)
+
+var _ = Context("core", func() {
+	Context("RemoveAuth", func() {
+		var db *badger.DB
+		var keyring *nodekey.Keyring
+		var pubSub pubsub.PubSub
+		var objectUnderTest core
+		BeforeEach(func() {
+			dbDir, err := ioutil.TempDir("", "")
+			if err != nil {
+				panic(err)
+			}
+
+			db, err = badger.Open(
+				badger.DefaultOptions(dbDir).WithLogger(nil),
+			)
+			if err != nil {
+				panic(err)
+			}
+
+			keyring, err = nodekey.New(bytes.Repeat([]byte{1}, nodekey.KeySize))
+			if err != nil {
+				panic(err)
+			}
+
+			pubSub = pubsub.New(db)
+
+			objectUnderTest = core{
+				pubSub: pubSub,
+				stateStore: mustNewStateStore(
+					context.Background(),
+					db,
+					keyring,
+					pubSub,
+				),
+			}
+		})
+
+		Context("auth not found", func() {
+			It("should return expected error", func() {
+				/* arrange/act */
+				actualErr := objectUnderTest.RemoveAuth(
+					context.Background(),
+					model.RemoveAuthReq{
+						Resources: "docker.io",
+					},
+				)
+
+				/* assert */
+				Expect(actualErr).To(MatchError("auth for 'docker.io' not found"))
+			})
+		})
+		Context("auth found", func() {
+			It("should publish AuthRemoved & auth should be removed", func() {
+				/* arrange */
+				eventChannel, err := pubSub.Subscribe(
+					context.Background(),
+					model.EventFilter{},
+				)
+				if err != nil {
+					panic(err)
+				}
+
+				if err := objectUnderTest.AddAuth(
+					context.Background(),
+					model.AddAuthReq{
+						Creds: model.Creds{
+							Username: "username",
+							Password: "password",
+						},
+						Resources: "docker.io",
+					},
+				); err != nil {
+					panic(err)
+				}
+
+				expectedEvent := model.Event{
+					AuthRemoved: &model.AuthRemoved{
+						Resources: "docker.io",
+					},
+					Timestamp: time.Now().UTC(),
+				}
+
+				/* act */
+				actualErr := objectUnderTest.RemoveAuth(
+					context.Background(),
+					model.RemoveAuthReq{
+						Resources: "docker.io",
+					},
+				)
+
+				/* assert */
+				Expect(actualErr).To(BeNil())
+
+				var actualEvent model.Event
+				go func() {
+					for event := range eventChannel {
+						if event.AuthRemoved != nil {
+							// ignore timestamp from assertion
+							event.Timestamp = expectedEvent.Timestamp
+							actualEvent = event
+						}
+					}
+				}()
+
+				Eventually(
+					func() model.Event { return actualEvent },
+				).Should(
+					Equal(expectedEvent),
+				)
+				Eventually(
+					func() *model.Auth { return objectUnderTest.stateStore.TryGetAuth("docker.io") },
+				).Should(
+					BeNil(),
+				)
+			})
+		})
+		Context("auth re-added", func() {
+			It("should keep re-added auth, including after restart", func() {
+				/* arrange */
+				addReq := model.AddAuthReq{
+					Creds: model.Creds{
+						Username: "username",
+						Password: "password",
+					},
+					Resources: "docker.io",
+				}
+				expectedAuth := &model.Auth{
+					Creds:     addReq.Creds,
+					Resources: addReq.Resources,
+				}
+
+				if err := objectUnderTest.AddAuth(context.Background(), addReq); err != nil {
+					panic(err)
+				}
+
+				/* act */
+				if err := objectUnderTest.RemoveAuth(
+					context.Background(),
+					model.RemoveAuthReq{
+						Resources: "docker.io",
+					},
+				); err != nil {
+					panic(err)
+				}
+
+				if err := objectUnderTest.AddAuth(context.Background(), addReq); err != nil {
+					panic(err)
+				}
+
+				/* assert */
+				Consistently(
+					func() *model.Auth { return objectUnderTest.stateStore.TryGetAuth("docker.io") },
+				).Should(
+					Equal(expectedAuth),
+				)
+
+				// restarting replays AuthAdded & AuthRemoved events
+				restartedStateStore := mustNewStateStore(
+					context.Background(),
+					db,
+					keyring,
+					pubsub.New(db),
+				)
+				Consistently(
+					func() *model.Auth { return restartedStateStore.TryGetAuth("docker.io") },
+				).Should(
+					Equal(expectedAuth),
+				)
+			})
+		})
+	})
+})
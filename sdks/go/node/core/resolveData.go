@@ -6,10 +6,12 @@ import (
 	"github.com/opctl/opctl/sdks/go/data"
 	"github.com/opctl/opctl/sdks/go/data/fs"
 	"github.com/opctl/opctl/sdks/go/data/git"
+	"github.com/opctl/opctl/sdks/go/data/oci"
+	"github.com/opctl/opctl/sdks/go/data/tarball"
 	"github.com/opctl/opctl/sdks/go/model"
 )
 
-// Resolve attempts to resolve data via local filesystem or git
+// Resolve attempts to resolve data via local filesystem, tarball, git, or oci registries
 // nil pullCreds will be ignored
 //
 // expected errs:
@@ -28,6 +30,8 @@ func (cr core) ResolveData(
 		ctx,
 		dataRef,
 		fs.New(),
+		tarball.New(cr.dataCachePath, pullCreds),
 		git.New(cr.dataCachePath, pullCreds),
+		oci.New(cr.dataCachePath, pullCreds),
 	)
 }
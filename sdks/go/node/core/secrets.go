@@ -0,0 +1,115 @@
+package core
+
+import (
+	"context"
+
+	"github.com/opctl/opctl/sdks/go/internal/redact"
+	"github.com/opctl/opctl/sdks/go/model"
+)
+
+// secretsCtxKey holds the secret values in scope of a call & its descendants
+type secretsCtxKey struct{}
+
+// withSecrets returns a copy of ctx w/ secrets added to its secrets
+func withSecrets(
+	ctx context.Context,
+	secrets []string,
+) context.Context {
+	return context.WithValue(ctx, secretsCtxKey{}, append(getSecrets(ctx), secrets...))
+}
+
+// getSecrets returns the secret values in scope of ctx
+func getSecrets(
+	ctx context.Context,
+) []string {
+	secrets, _ := ctx.Value(secretsCtxKey{}).([]string)
+	// copy so appends don't alter secrets of other contexts
+	return append([]string{}, secrets...)
+}
+
+// redactCall returns a copy of call safe to publish; secrets & creds are replaced w/ a mask
+// while the original, which the runtime receives, is left as is
+func redactCall(
+	call model.Call,
+	secrets []string,
+) model.Call {
+	redactor := redact.New(secrets)
+
+	if call.Container != nil {
+		container := *call.Container
+
+		if call.Container.Cmd != nil {
+			container.Cmd = []string{}
+			for _, arg := range call.Container.Cmd {
+				container.Cmd = append(container.Cmd, redactor.RedactString(arg))
+			}
+		}
+
+		if call.Container.EnvVars != nil {
+			container.EnvVars = map[string]string{}
+			for name, value := range call.Container.EnvVars {
+				container.EnvVars[name] = redactor.RedactString(value)
+			}
+		}
+
+		if call.Container.Image != nil {
+			image := *call.Container.Image
+			if image.PullCreds != nil {
+				image.PullCreds = &model.Creds{
+					Username: image.PullCreds.Username,
+					Password: redact.Mask,
+				}
+			}
+			container.Image = &image
+		}
+
+		call.Container = &container
+	}
+
+	if call.Op != nil {
+		op := *call.Op
+		op.Inputs = redactValues(call.Op.Inputs, secrets)
+		call.Op = &op
+	}
+
+	return call
+}
+
+// redactValues returns a copy of values safe to publish; values which are or contain secrets are replaced w/ a mask
+func redactValues(
+	values map[string]*model.Value,
+	secrets []string,
+) map[string]*model.Value {
+	if values == nil {
+		return nil
+	}
+
+	redactor := redact.New(secrets)
+
+	isSecret := map[string]bool{}
+	for _, secret := range secrets {
+		isSecret[secret] = true
+	}
+
+	redacted := map[string]*model.Value{}
+	for name, value := range values {
+		redacted[name] = value
+		if value == nil {
+			continue
+		}
+		if value.String != nil {
+			redactedString := redactor.RedactString(*value.String)
+			redacted[name] = &model.Value{String: &redactedString}
+			continue
+		}
+		for _, form := range redact.Values(*value) {
+			if isSecret[form] {
+				mask := redact.Mask
+				redacted[name] = &model.Value{String: &mask}
+				break
+			}
+		}
+	}
+
+	return redacted
+}
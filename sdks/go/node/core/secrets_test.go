@@ -0,0 +1,78 @@
+package core
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/opctl/opctl/sdks/go/model"
+)
+
+var _ = Context("secrets", func() {
+	Context("withSecrets", func() {
+		It("should add to secrets of ctx", func() {
+			/* arrange */
+			parentCtx := withSecrets(context.Background(), []string{"parent"})
+
+			/* act */
+			actualCtx := withSecrets(parentCtx, []string{"child"})
+
+			/* assert */
+			Expect(getSecrets(actualCtx)).To(Equal([]string{"parent", "child"}))
+			Expect(getSecrets(parentCtx)).To(Equal([]string{"parent"}))
+		})
+	})
+	Context("redactCall", func() {
+		It("should redact a copy of call", func() {
+			/* arrange */
+			secret := "hunter2"
+			ref := "ref"
+			providedCall := model.Call{
+				Container: &model.ContainerCall{
+					Cmd:     []string{"echo", "password=hunter2"},
+					EnvVars: map[string]string{"PASSWORD": secret},
+					Image: &model.ContainerCallImage{
+						Ref:       &ref,
+						PullCreds: &model.Creds{Username: "user", Password: "pass"},
+					},
+				},
+			}
+
+			/* act */
+			actualCall := redactCall(providedCall, []string{secret})
+
+			/* assert */
+			Expect(actualCall.Container.Cmd).To(Equal([]string{"echo", "password=***"}))
+			Expect(actualCall.Container.EnvVars).To(Equal(map[string]string{"PASSWORD": "***"}))
+			Expect(*actualCall.Container.Image.PullCreds).To(Equal(model.Creds{Username: "user", Password: "***"}))
+
+			// original left as is
+			Expect(providedCall.Container.EnvVars["PASSWORD"]).To(Equal(secret))
+			Expect(providedCall.Container.Image.PullCreds.Password).To(Equal("pass"))
+		})
+		It("should redact secret op inputs", func() {
+			/* arrange */
+			secret := "hunter2"
+			number := 1234.0
+			providedCall := model.Call{
+				Op: &model.OpCall{
+					Inputs: map[string]*model.Value{
+						"password": {String: &secret},
+						"pin":      {Number: &number},
+					},
+				},
+			}
+
+			/* act */
+			actualCall := redactCall(providedCall, []string{secret, "1234"})
+
+			/* assert */
+			mask := "***"
+			Expect(actualCall.Op.Inputs).To(Equal(map[string]*model.Value{
+				"password": {String: &mask},
+				"pin":      {String: &mask},
+			}))
+			Expect(*providedCall.Op.Inputs["password"].String).To(Equal(secret))
+		})
+	})
+})
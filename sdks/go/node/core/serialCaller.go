@@ -20,6 +20,7 @@ type serialCaller interface {
 		rootCallID string,
 		opPath string,
 		callSpecSerialCall []*model.CallSpec,
+		callSpecFinally *model.CallSpec,
 	) (
 		map[string]*model.Value,
 		error,
@@ -50,6 +51,7 @@ func (sc _serialCaller) Call(
 	rootCallID string,
 	opPath string,
 	callSpecSerialCall []*model.CallSpec,
+	callSpecFinally *model.CallSpec,
 ) (
 	map[string]*model.Value,
 	error,
@@ -70,16 +72,24 @@ func (sc _serialCaller) Call(
 		},
 	)
 
+	var err error
+
+serialLoop:
 	for _, callSpecCall := range callSpecSerialCall {
+		if ctx.Err() != nil {
+			// killed; call no further children
+			break
+		}
 
 		var childCallID string
-		childCallID, err := uniquestring.Construct()
+		childCallID, err = uniquestring.Construct()
 		if err != nil {
 			// end run immediately on any error
-			return nil, err
+			break
 		}
 
-		sc.caller.Call(
+		// outputs of CallEnded events are redacted so take them from the call
+		childCallOutputs, _ := sc.caller.Call(
 			ctx,
 			childCallID,
 			outputs,
@@ -96,9 +106,10 @@ func (sc _serialCaller) Call(
 			case event.CallEnded != nil && event.CallEnded.Call.ID == childCallID:
 				if event.CallEnded.Error != nil {
 					// end on any error
-					return nil, errors.New(event.CallEnded.Error.Message)
+					err = errors.New(event.CallEnded.Error.Message)
+					break serialLoop
 				}
-				for name, value := range event.CallEnded.Outputs {
+				for name, value := range childCallOutputs {
 					outputs[name] = value
 				}
 				break eventLoop
@@ -107,5 +118,29 @@ func (sc _serialCaller) Call(
 
 	}
 
+	if callSpecFinally != nil {
+		finallyOutputs, finallyErr := callFinally(
+			ctx,
+			sc.caller,
+			callSpecFinally,
+			outputs,
+			err,
+			opPath,
+			&callID,
+			rootCallID,
+		)
+		if err == nil {
+			// finally failing fails the serial call unless it already failed
+			err = finallyErr
+		}
+		for name, value := range finallyOutputs {
+			outputs[name] = value
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
 	return outputs, nil
 }
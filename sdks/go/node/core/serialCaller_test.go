@@ -8,6 +8,7 @@ import (
 
 	"io"
 	"path/filepath"
+	"time"
 
 	"github.com/dgraph-io/badger/v2"
 	. "github.com/onsi/ginkgo"
@@ -15,6 +16,7 @@ import (
 	"github.com/opctl/opctl/sdks/go/model"
 	containerRuntimeFakes "github.com/opctl/opctl/sdks/go/node/core/containerruntime/fakes"
 	. "github.com/opctl/opctl/sdks/go/node/core/internal/fakes"
+	"github.com/opctl/opctl/sdks/go/node/core/nodekey"
 	"github.com/opctl/opctl/sdks/go/pubsub"
 	. "github.com/opctl/opctl/sdks/go/pubsub/fakes"
 )
@@ -52,9 +54,10 @@ var _ = Context("serialCaller", func() {
 						newContainerCaller(
 							new(containerRuntimeFakes.FakeContainerRuntime),
 							pubSub,
-							newStateStore(
+							mustNewStateStore(
 								context.Background(),
 								db,
+								new(nodekey.Keyring),
 								pubSub,
 							),
 						),
@@ -77,12 +80,226 @@ var _ = Context("serialCaller", func() {
 							Container: &model.ContainerCallSpec{},
 						},
 					},
+					nil,
 				)
 
 				/* assert */
 				Expect(actualErr).To(MatchError("image required"))
 			})
 		})
+		Context("finally set", func() {
+			// newFakeCaller returns a caller which fails children w/ childErrMsg & records the ctx & scope of finallyCallSpec
+			newFakeCaller := func(
+				pubSub pubsub.PubSub,
+				finallyCallSpec *model.CallSpec,
+				childErrMsg string,
+				actualFinallyCtx *context.Context,
+				actualFinallyScope *map[string]*model.Value,
+			) *FakeCaller {
+				fakeCaller := new(FakeCaller)
+				fakeCaller.CallStub = func(
+					ctx context.Context,
+					id string,
+					scope map[string]*model.Value,
+					callSpec *model.CallSpec,
+					opPath string,
+					parentCallID *string,
+					rootCallID string,
+				) (map[string]*model.Value, error) {
+					if callSpec == finallyCallSpec {
+						*actualFinallyCtx = ctx
+						*actualFinallyScope = scope
+						return nil, nil
+					}
+
+					if ctx.Err() != nil {
+						// killed
+						return nil, nil
+					}
+
+					pubSub.Publish(
+						model.Event{
+							CallEnded: &model.CallEnded{
+								Call: model.Call{
+									ID:     id,
+									RootID: rootCallID,
+								},
+								Error: &model.CallEndedError{
+									Message: childErrMsg,
+								},
+							},
+							Timestamp: time.Now().UTC(),
+						},
+					)
+					return nil, nil
+				}
+				return fakeCaller
+			}
+
+			Context("child fails", func() {
+				It("should call finally w/ outcome & error in scope", func() {
+					/* arrange */
+					dbDir, err := ioutil.TempDir("", "")
+					if err != nil {
+						panic(err)
+					}
+
+					db, err := badger.Open(
+						badger.DefaultOptions(dbDir).WithLogger(nil),
+					)
+					if err != nil {
+						panic(err)
+					}
+					pubSub := pubsub.New(db)
+
+					providedFinally := &model.CallSpec{}
+					var actualFinallyCtx context.Context
+					var actualFinallyScope map[string]*model.Value
+
+					objectUnderTest := _serialCaller{
+						caller: newFakeCaller(pubSub, providedFinally, "childErr", &actualFinallyCtx, &actualFinallyScope),
+						pubSub: pubSub,
+					}
+
+					/* act */
+					_, actualErr := objectUnderTest.Call(
+						context.Background(),
+						"callID",
+						map[string]*model.Value{},
+						"rootCallID",
+						"opPath",
+						[]*model.CallSpec{{}, {}},
+						providedFinally,
+					)
+
+					/* assert */
+					Expect(actualErr).To(MatchError("childErr"))
+					Expect(*actualFinallyScope["outcome"].String).To(Equal(model.OpOutcomeFailed))
+					Expect(*actualFinallyScope["error"].String).To(Equal("childErr"))
+				})
+			})
+
+			Context("killed", func() {
+				It("should call finally w/ live ctx & killed outcome in scope", func() {
+					/* arrange */
+					dbDir, err := ioutil.TempDir("", "")
+					if err != nil {
+						panic(err)
+					}
+
+					db, err := badger.Open(
+						badger.DefaultOptions(dbDir).WithLogger(nil),
+					)
+					if err != nil {
+						panic(err)
+					}
+					pubSub := pubsub.New(db)
+
+					providedCtx, cancel := context.WithCancel(context.Background())
+					cancel()
+
+					providedFinally := &model.CallSpec{}
+					var actualFinallyCtx context.Context
+					var actualFinallyScope map[string]*model.Value
+
+					objectUnderTest := _serialCaller{
+						caller: newFakeCaller(pubSub, providedFinally, "childErr", &actualFinallyCtx, &actualFinallyScope),
+						pubSub: pubSub,
+					}
+
+					/* act */
+					objectUnderTest.Call(
+						providedCtx,
+						"callID",
+						map[string]*model.Value{},
+						"rootCallID",
+						"opPath",
+						[]*model.CallSpec{{}},
+						providedFinally,
+					)
+
+					/* assert */
+					Expect(actualFinallyCtx.Err()).To(BeNil())
+					Expect(actualFinallyCtx.Value(isFinallyCtxKey{})).To(Equal(true))
+					Expect(*actualFinallyScope["outcome"].String).To(Equal(model.OpOutcomeKilled))
+				})
+			})
+
+			Context("finally outputs its scope", func() {
+				It("should not add outcome & error to outputs", func() {
+					/* arrange */
+					dbDir, err := ioutil.TempDir("", "")
+					if err != nil {
+						panic(err)
+					}
+
+					db, err := badger.Open(
+						badger.DefaultOptions(dbDir).WithLogger(nil),
+					)
+					if err != nil {
+						panic(err)
+					}
+					pubSub := pubsub.New(db)
+
+					providedFinally := &model.CallSpec{}
+					providedOutcome := "providedOutcome"
+
+					fakeCaller := new(FakeCaller)
+					fakeCaller.CallStub = func(
+						ctx context.Context,
+						id string,
+						scope map[string]*model.Value,
+						callSpec *model.CallSpec,
+						opPath string,
+						parentCallID *string,
+						rootCallID string,
+					) (map[string]*model.Value, error) {
+						if callSpec == providedFinally {
+							// i.e. a serial call
+							return scope, nil
+						}
+
+						pubSub.Publish(
+							model.Event{
+								CallEnded: &model.CallEnded{
+									Call: model.Call{
+										ID:     id,
+										RootID: rootCallID,
+									},
+								},
+								Timestamp: time.Now().UTC(),
+							},
+						)
+						return nil, nil
+					}
+
+					objectUnderTest := _serialCaller{
+						caller: fakeCaller,
+						pubSub: pubSub,
+					}
+
+					/* act */
+					actualOutputs, actualErr := objectUnderTest.Call(
+						context.Background(),
+						"callID",
+						map[string]*model.Value{
+							"outcome": {String: &providedOutcome},
+						},
+						"rootCallID",
+						"opPath",
+						[]*model.CallSpec{{}},
+						providedFinally,
+					)
+
+					/* assert */
+					Expect(actualErr).To(BeNil())
+					Expect(actualOutputs).To(Equal(map[string]*model.Value{
+						"outcome": {String: &providedOutcome},
+					}))
+				})
+			})
+		})
+
 		It("should start each child as expected", func() {
 			/* arrange */
 			dbDir, err := ioutil.TempDir("", "")
@@ -150,9 +367,10 @@ var _ = Context("serialCaller", func() {
 					newContainerCaller(
 						fakeContainerRuntime,
 						pubSub,
-						newStateStore(
+						mustNewStateStore(
 							ctx,
 							db,
+							new(nodekey.Keyring),
 							pubSub,
 						),
 					),
@@ -190,6 +408,7 @@ var _ = Context("serialCaller", func() {
 						},
 					},
 				},
+				nil,
 			)
 
 			/* assert */
@@ -221,6 +440,7 @@ var _ = Context("serialCaller", func() {
 									BaseCall: model.BaseCall{
 										OpPath: childOp1Path,
 									},
+									Ref:               childOp1Path,
 									Inputs:            providedInboundScope,
 									ChildCallCallSpec: nil,
 								},
@@ -235,6 +455,7 @@ var _ = Context("serialCaller", func() {
 									BaseCall: model.BaseCall{
 										OpPath: childOp2Path,
 									},
+									Ref: childOp2Path,
 									Inputs: map[string]*model.Value{
 										input2Key: {String: &input2Value},
 									},
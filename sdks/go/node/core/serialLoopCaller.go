@@ -3,6 +3,7 @@ package core
 import (
 	"context"
 	"errors"
+	"fmt"
 	"time"
 
 	"github.com/opctl/opctl/sdks/go/opspec/interpreter/call/loop"
@@ -58,14 +59,23 @@ func (lpr _serialLoopCaller) Call(
 	map[string]*model.Value,
 	error,
 ) {
+	loopRangeSpec, err := serialloop.RangeSpec(
+		callSpecSerialLoop,
+		inboundScope,
+	)
+	if err != nil {
+		return nil, err
+	}
+
 	outboundScope := map[string]*model.Value{}
+	iterationOutputs := []map[string]*model.Value{}
 	var callSerialLoop *model.SerialLoopCall
 
 	index := 0
-	outboundScope, err := iteration.Scope(
+	outboundScope, err = iteration.Scope(
 		index,
 		inboundScope,
-		callSpecSerialLoop.Range,
+		loopRangeSpec,
 		callSpecSerialLoop.Vars,
 	)
 	if err != nil {
@@ -90,7 +100,8 @@ func (lpr _serialLoopCaller) Call(
 			return nil, err
 		}
 
-		lpr.caller.Call(
+		// outputs of CallEnded events are redacted so take them from the call
+		childCallOutputs, _ := lpr.caller.Call(
 			ctx,
 			callID,
 			outboundScope,
@@ -119,9 +130,10 @@ func (lpr _serialLoopCaller) Call(
 					err = errors.New(event.CallEnded.Error.Message)
 					return nil, err
 				}
-				for name, value := range event.CallEnded.Outputs {
+				for name, value := range childCallOutputs {
 					outboundScope[name] = value
 				}
+				iterationOutputs = append(iterationOutputs, childCallOutputs)
 				break eventLoop
 			}
 		}
@@ -135,7 +147,7 @@ func (lpr _serialLoopCaller) Call(
 		outboundScope, err = iteration.Scope(
 			index,
 			outboundScope,
-			callSpecSerialLoop.Range,
+			loopRangeSpec,
 			callSpecSerialLoop.Vars,
 		)
 		if err != nil {
@@ -150,14 +162,35 @@ func (lpr _serialLoopCaller) Call(
 		if err != nil {
 			return nil, err
 		}
+
+		if serialloop.IsIterationComplete(index, callSerialLoop) {
+			break
+		}
+
+		if callSerialLoop.MaxIterations != nil && index >= *callSerialLoop.MaxIterations {
+			return nil, fmt.Errorf("serial loop not done after maxIterations (%v) iterations", *callSerialLoop.MaxIterations)
+		}
+
+		if callSerialLoop.Delay != nil {
+			select {
+			case <-ctx.Done():
+				return nil, ctx.Err()
+			case <-time.After(*callSerialLoop.Delay):
+			}
+		}
 	}
 
 	outboundScope = loop.DeScope(
 		inboundScope,
-		callSpecSerialLoop.Range,
+		loopRangeSpec,
 		callSpecSerialLoop.Vars,
 		outboundScope,
 	)
 
-	return outboundScope, err
+	return loop.Collect(
+		outboundScope,
+		callSpecSerialLoop.Collect,
+		loopRangeSpec,
+		iterationOutputs,
+	)
 }
@@ -5,6 +5,7 @@ import (
 	"io/ioutil"
 
 	"io"
+	"time"
 
 	"github.com/dgraph-io/badger/v2"
 	containerRuntimeFakes "github.com/opctl/opctl/sdks/go/node/core/containerruntime/fakes"
@@ -14,6 +15,7 @@ import (
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
 	"github.com/opctl/opctl/sdks/go/model"
+	"github.com/opctl/opctl/sdks/go/node/core/nodekey"
 	. "github.com/opctl/opctl/sdks/go/pubsub/fakes"
 )
 
@@ -90,6 +92,152 @@ var _ = Context("serialLoopCaller", func() {
 				Expect(fakeCaller.CallCallCount()).To(Equal(0))
 			})
 		})
+		Context("until never true & maxIterations set", func() {
+			It("should return expected error after maxIterations", func() {
+				/* arrange */
+				dbDir, err := ioutil.TempDir("", "")
+				if err != nil {
+					panic(err)
+				}
+
+				db, err := badger.Open(
+					badger.DefaultOptions(dbDir).WithLogger(nil),
+				)
+				if err != nil {
+					panic(err)
+				}
+				pubSub := pubsub.New(db)
+
+				fakeCaller := new(FakeCaller)
+				fakeCaller.CallStub = func(
+					ctx context.Context,
+					id string,
+					scope map[string]*model.Value,
+					callSpec *model.CallSpec,
+					opPath string,
+					parentCallID *string,
+					rootCallID string,
+				) (map[string]*model.Value, error) {
+					go pubSub.Publish(
+						model.Event{
+							CallEnded: &model.CallEnded{
+								Call: model.Call{
+									ID:     id,
+									RootID: rootCallID,
+								},
+							},
+							Timestamp: time.Now().UTC(),
+						},
+					)
+
+					return nil, nil
+				}
+
+				objectUnderTest := _serialLoopCaller{
+					caller: fakeCaller,
+					pubSub: pubSub,
+				}
+
+				/* act */
+				_, actualErr := objectUnderTest.Call(
+					context.Background(),
+					"id",
+					map[string]*model.Value{},
+					model.SerialLoopCallSpec{
+						Delay:         "1ms",
+						MaxIterations: 3,
+						Until: []*model.PredicateSpec{
+							{Eq: &[]interface{}{true, false}},
+						},
+					},
+					"opPath",
+					nil,
+					"rootCallID",
+				)
+
+				/* assert */
+				Expect(actualErr).To(MatchError("serial loop not done after maxIterations (3) iterations"))
+				Expect(fakeCaller.CallCallCount()).To(Equal(3))
+			})
+		})
+		Context("matrix set", func() {
+			It("should call caller.Call w/ each combination", func() {
+				/* arrange */
+				dbDir, err := ioutil.TempDir("", "")
+				if err != nil {
+					panic(err)
+				}
+
+				db, err := badger.Open(
+					badger.DefaultOptions(dbDir).WithLogger(nil),
+				)
+				if err != nil {
+					panic(err)
+				}
+				pubSub := pubsub.New(db)
+
+				valueName := "combination"
+				actualCombinations := []interface{}{}
+
+				fakeCaller := new(FakeCaller)
+				fakeCaller.CallStub = func(
+					ctx context.Context,
+					id string,
+					scope map[string]*model.Value,
+					callSpec *model.CallSpec,
+					opPath string,
+					parentCallID *string,
+					rootCallID string,
+				) (map[string]*model.Value, error) {
+					actualCombinations = append(actualCombinations, *scope[valueName].Object)
+
+					go pubSub.Publish(
+						model.Event{
+							CallEnded: &model.CallEnded{
+								Call: model.Call{
+									ID:     id,
+									RootID: rootCallID,
+								},
+							},
+							Timestamp: time.Now().UTC(),
+						},
+					)
+
+					return nil, nil
+				}
+
+				objectUnderTest := _serialLoopCaller{
+					caller: fakeCaller,
+					pubSub: pubSub,
+				}
+
+				/* act */
+				_, actualErr := objectUnderTest.Call(
+					context.Background(),
+					"id",
+					map[string]*model.Value{},
+					model.SerialLoopCallSpec{
+						Matrix: map[string]interface{}{
+							"go": []interface{}{"1.14", "1.15"},
+							"os": []interface{}{"linux"},
+						},
+						Vars: &model.LoopVarsSpec{
+							Value: &valueName,
+						},
+					},
+					"opPath",
+					nil,
+					"rootCallID",
+				)
+
+				/* assert */
+				Expect(actualErr).To(BeNil())
+				Expect(actualCombinations).To(Equal([]interface{}{
+					map[string]interface{}{"go": "1.14", "os": "linux"},
+					map[string]interface{}{"go": "1.15", "os": "linux"},
+				}))
+			})
+		})
 		Context("initial callSerialLoop.Until false", func() {
 
 			Context("iteration spec invalid", func() {
@@ -116,9 +264,10 @@ var _ = Context("serialLoopCaller", func() {
 						newContainerCaller(
 							new(containerRuntimeFakes.FakeContainerRuntime),
 							pubSub,
-							newStateStore(
+							mustNewStateStore(
 								context.Background(),
 								db,
+								new(nodekey.Keyring),
 								pubSub,
 							),
 						),
@@ -206,9 +355,10 @@ var _ = Context("serialLoopCaller", func() {
 						newContainerCaller(
 							fakeContainerRuntime,
 							pubSub,
-							newStateStore(
+							mustNewStateStore(
 								ctx,
 								db,
+								new(nodekey.Keyring),
 								pubSub,
 							),
 						),
@@ -8,25 +8,38 @@ import (
 	"github.com/opctl/opctl/sdks/go/data"
 	"github.com/opctl/opctl/sdks/go/data/fs"
 	"github.com/opctl/opctl/sdks/go/data/git"
+	"github.com/opctl/opctl/sdks/go/data/oci"
+	"github.com/opctl/opctl/sdks/go/data/tarball"
 	"github.com/opctl/opctl/sdks/go/internal/uniquestring"
 	"github.com/opctl/opctl/sdks/go/model"
 	"github.com/opctl/opctl/sdks/go/opspec/opfile"
+	"github.com/opctl/opctl/sdks/go/opspec/signature"
 )
 
 func (this core) StartOp(
 	ctx context.Context,
 	req model.StartOpReq,
 ) (string, error) {
+	// version ranges resolve to the same version throughout the op
+	ctx = git.WithResolvedVersions(ctx)
+
 	opHandle, err := data.Resolve(
 		ctx,
 		req.Op.Ref,
 		fs.New(),
+		// before git so tarball URLs aren't cloned
+		tarball.New(this.dataCachePath, req.Op.PullCreds),
 		git.New(this.dataCachePath, req.Op.PullCreds),
+		oci.New(this.dataCachePath, req.Op.PullCreds),
 	)
 	if err != nil {
 		return "", err
 	}
 
+	if err := signature.EnforcePolicy(*opHandle.Path(), this.dataCachePath, this.trustedKeysPath); err != nil {
+		return "", err
+	}
+
 	callID, err := uniquestring.Construct()
 	if err != nil {
 		// end run immediately on any error
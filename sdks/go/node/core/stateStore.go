@@ -10,6 +10,7 @@ import (
 
 	"github.com/dgraph-io/badger/v2"
 	"github.com/opctl/opctl/sdks/go/model"
+	"github.com/opctl/opctl/sdks/go/node/core/nodekey"
 	"github.com/opctl/opctl/sdks/go/pubsub"
 )
 
@@ -20,27 +21,59 @@ import (
 // efficient startup:
 // A lastAppliedEventTimestamp is maintained and used at startup to pickup applying events
 // from where we left off.
+//
+// auth:
+// Auths are written synchronously by AddAuth & RemoveAuth rather than materialized from events,
+// so replayed AuthAdded & AuthRemoved events can't undo later changes.
+//
+// auth at rest:
+// Auths are encrypted w/ the node keyring. At startup, auths not encrypted w/ its primary key
+// are re-encrypted w/ it; auths stored in plaintext by prior versions are encrypted at the first
+// startup only, after which plaintext is never accepted. Stored AuthAdded events published by
+// prior versions, which include the password, are rewritten w/out it.
 type stateStore interface {
+	// AddAuth stores auth; auth isn't materialized from AuthAdded events since they omit passwords
+	AddAuth(auth model.Auth) error
+
+	// ListAuths lists auths ordered by resources; passwords are omitted
+	ListAuths() []model.Auth
+
+	// RemoveAuth removes the auth w/ resources (case insensitive)
+	RemoveAuth(resources string) error
+
 	// lists all calls w/ parentID
 	ListWithParentID(parentID string) []*model.Call
 
 	TryGet(id string) *model.Call
 
-	// TryGetCreds returns creds for a ref if any exist
+	// TryGetAuth returns the auth whose resources are the longest prefix of resource, if any
 	TryGetAuth(resource string) *model.Auth
 }
 
 func newStateStore(
 	ctx context.Context,
 	db *badger.DB,
+	keyring *nodekey.Keyring,
 	pubSub pubsub.PubSub,
-) stateStore {
+) (stateStore, error) {
 
 	stateStore := &_stateStore{
 		authsByResourcesKeyPrefix:    "authsByResources_",
 		callsByID:                    make(map[string]*model.Call),
 		db:                           db,
+		keyring:                      keyring,
 		lastAppliedEventTimestampKey: "lastAppliedEventTimestamp",
+		plaintextAuthsMigratedKey:    "plaintextAuthsMigrated",
+	}
+
+	// events must be scrubbed before they're replayed
+	if err := stateStore.scrubAuthAddedEvents(pubSub); err != nil {
+		return nil, err
+	}
+
+	// auths must be re-encrypted before prior keys are discarded
+	if err := stateStore.reencryptAuths(); err != nil {
+		return nil, err
 	}
 
 	go func() {
@@ -62,8 +95,6 @@ func newStateStore(
 
 		for event := range eventChannel {
 			switch {
-			case event.AuthAdded != nil:
-				stateStore.applyAuthAdded(*event.AuthAdded)
 			case event.CallEnded != nil:
 				stateStore.applyCallEnded(*event.CallEnded)
 			case event.CallStarted != nil:
@@ -74,7 +105,7 @@ func newStateStore(
 		}
 	}()
 
-	return stateStore
+	return stateStore, nil
 
 }
 
@@ -83,6 +114,8 @@ type _stateStore struct {
 	authsByResourcesKeyPrefix    string
 	callsByID                    map[string]*model.Call
 	db                           *badger.DB
+	keyring                      *nodekey.Keyring
+	plaintextAuthsMigratedKey    string
 	// synchronize access via mutex
 	mux sync.RWMutex
 }
@@ -119,17 +152,125 @@ func (ss *_stateStore) updateLastAppliedEventTimestamp(lastAppliedEventTimestamp
 	})
 }
 
-func (ss *_stateStore) applyAuthAdded(authAdded model.AuthAdded) error {
+func (ss *_stateStore) AddAuth(auth model.Auth) error {
+	encodedAuth, err := json.Marshal(auth)
+	if err != nil {
+		return err
+	}
+
+	encryptedAuth, err := ss.keyring.Encrypt(encodedAuth)
+	if err != nil {
+		return err
+	}
+
 	return ss.db.Update(func(txn *badger.Txn) error {
-		auth := authAdded.Auth
-		encodedAuth, err := json.Marshal(auth)
-		if err != nil {
+		return txn.Set(
+			[]byte(ss.authsByResourcesKeyPrefix+strings.ToLower(auth.Resources)),
+			encryptedAuth,
+		)
+	})
+}
+
+// scrubAuthAddedEvents rewrites stored AuthAdded events published by prior versions w/out the password;
+// their auths are stored first (in the order they were added) in case they weren't applied yet
+func (ss *_stateStore) scrubAuthAddedEvents(
+	eventRewriter pubsub.EventRewriter,
+) error {
+	return eventRewriter.RewriteEvents(
+		func(event model.Event) (model.Event, bool, error) {
+			if event.AuthAdded == nil || event.AuthAdded.Auth.Password == "" {
+				return event, false, nil
+			}
+
+			if err := ss.AddAuth(event.AuthAdded.Auth); err != nil {
+				return event, false, err
+			}
+
+			event.AuthAdded.Auth.Password = ""
+			return event, true, nil
+		},
+	)
+}
+
+func (ss *_stateStore) RemoveAuth(resources string) error {
+	return ss.db.Update(func(txn *badger.Txn) error {
+		return txn.Delete(
+			[]byte(ss.authsByResourcesKeyPrefix + strings.ToLower(resources)),
+		)
+	})
+}
+
+// decryptAuth decrypts an encrypted auth
+func (ss *_stateStore) decryptAuth(
+	value []byte,
+) (auth *model.Auth, isPrimary bool, err error) {
+	plaintext, isPrimary, err := ss.keyring.Decrypt(value)
+	if err != nil {
+		return nil, false, err
+	}
+
+	auth = &model.Auth{}
+	if err := json.Unmarshal(plaintext, auth); err != nil {
+		return nil, false, err
+	}
+
+	return auth, isPrimary, nil
+}
+
+// reencryptAuths re-encrypts auths not encrypted w/ the primary key of the keyring;
+// auths stored in plaintext by prior versions are encrypted the first time it's called only
+func (ss *_stateStore) reencryptAuths() error {
+	isPlaintextMigrated := false
+	authsToReencrypt := []*model.Auth{}
+	err := ss.db.View(func(txn *badger.Txn) error {
+		if _, err := txn.Get([]byte(ss.plaintextAuthsMigratedKey)); err == nil {
+			isPlaintextMigrated = true
+		} else if err != badger.ErrKeyNotFound {
 			return err
 		}
 
+		it := txn.NewIterator(badger.DefaultIteratorOptions)
+		defer it.Close()
+		prefixBytes := []byte(ss.authsByResourcesKeyPrefix)
+		for it.Seek(prefixBytes); it.ValidForPrefix(prefixBytes); it.Next() {
+			it.Item().Value(func(value []byte) error {
+				auth, isPrimary, err := ss.decryptAuth(value)
+				if err == nil {
+					if !isPrimary {
+						authsToReencrypt = append(authsToReencrypt, auth)
+					}
+					return nil
+				}
+
+				if !isPlaintextMigrated {
+					plaintextAuth := &model.Auth{}
+					if err := json.Unmarshal(value, plaintextAuth); err == nil {
+						authsToReencrypt = append(authsToReencrypt, plaintextAuth)
+					}
+				}
+				return nil
+			})
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	for _, auth := range authsToReencrypt {
+		if err := ss.AddAuth(*auth); err != nil {
+			return err
+		}
+	}
+
+	if isPlaintextMigrated {
+		return nil
+	}
+
+	return ss.db.Update(func(txn *badger.Txn) error {
 		return txn.Set(
-			[]byte(ss.authsByResourcesKeyPrefix+strings.ToLower(auth.Resources)),
-			encodedAuth,
+			[]byte(ss.plaintextAuthsMigratedKey),
+			[]byte(strconv.FormatBool(true)),
 		)
 	})
 }
@@ -157,6 +298,36 @@ func (ss *_stateStore) applyCallStarted(callStarted model.CallStarted) {
 	ss.callsByID[call.ID] = &call
 }
 
+// O(n) complexity (n being auth count)
+func (ss *_stateStore) ListAuths() []model.Auth {
+	auths := []model.Auth{}
+	ss.db.View(func(txn *badger.Txn) error {
+		it := txn.NewIterator(badger.DefaultIteratorOptions)
+		defer it.Close()
+		prefixBytes := []byte(ss.authsByResourcesKeyPrefix)
+		for it.Seek(prefixBytes); it.ValidForPrefix(prefixBytes); it.Next() {
+			item := it.Item()
+			auth := model.Auth{
+				// listed even if undecryptable so it can be removed
+				Resources: strings.TrimPrefix(string(item.Key()), ss.authsByResourcesKeyPrefix),
+			}
+
+			item.Value(func(value []byte) error {
+				if decryptedAuth, _, err := ss.decryptAuth(value); err == nil {
+					auth.Resources = decryptedAuth.Resources
+					auth.Username = decryptedAuth.Username
+				}
+				return nil
+			})
+
+			auths = append(auths, auth)
+		}
+		return nil
+	})
+
+	return auths
+}
+
 // O(n) complexity (n being active call count)
 func (ss *_stateStore) ListWithParentID(parentID string) []*model.Call {
 	ss.mux.RLock()
@@ -189,6 +360,7 @@ func (ss *_stateStore) TryGetAuth(
 ) *model.Auth {
 	ref = strings.ToLower(ref)
 	var auth *model.Auth
+	matchedPrefixLen := -1
 	ss.db.View(func(txn *badger.Txn) error {
 		opts := badger.DefaultIteratorOptions
 		opts.PrefetchValues = false
@@ -200,10 +372,14 @@ func (ss *_stateStore) TryGetAuth(
 			key := string(item.Key())
 			prefix := strings.TrimPrefix(key, ss.authsByResourcesKeyPrefix)
 
-			if strings.HasPrefix(ref, prefix) {
+			// longest prefix wins
+			if strings.HasPrefix(ref, prefix) && len(prefix) > matchedPrefixLen {
 				item.Value(func(value []byte) error {
-					auth = &model.Auth{}
-					return json.Unmarshal(value, auth)
+					if decryptedAuth, _, err := ss.decryptAuth(value); err == nil {
+						auth = decryptedAuth
+						matchedPrefixLen = len(prefix)
+					}
+					return nil
 				})
 			}
 		}
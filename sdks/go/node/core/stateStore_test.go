@@ -1,7 +1,10 @@
 package core
 
 import (
+	"bytes"
 	"context"
+	"encoding/json"
+	"errors"
 	"io/ioutil"
 	"time"
 
@@ -10,49 +13,381 @@ import (
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
 	"github.com/opctl/opctl/sdks/go/model"
+	"github.com/opctl/opctl/sdks/go/node/core/nodekey"
 	"github.com/opctl/opctl/sdks/go/pubsub"
+	. "github.com/opctl/opctl/sdks/go/pubsub/fakes"
 )
 
+// mustNewStateStore returns a new stateStore; it panics if one can't be initialized
+func mustNewStateStore(
+	ctx context.Context,
+	db *badger.DB,
+	keyring *nodekey.Keyring,
+	pubSub pubsub.PubSub,
+) stateStore {
+	stateStore, err := newStateStore(ctx, db, keyring, pubSub)
+	if err != nil {
+		panic(err)
+	}
+	return stateStore
+}
+
 var _ = Context("stateStore", func() {
-	Context("TryGetAuth", func() {
-		Context("AuthAdded", func() {
-			It("should return expected auth", func() {
+	var db *badger.DB
+	var keyring *nodekey.Keyring
+	expectedAuth := model.Auth{
+		Creds: model.Creds{
+			Username: "username",
+			Password: "password",
+		},
+		Resources: "resources",
+	}
+
+	// storedAuthValue returns the value stored for expectedAuth
+	storedAuthValue := func() []byte {
+		var value []byte
+		err := db.View(func(txn *badger.Txn) error {
+			item, err := txn.Get([]byte("authsByResources_resources"))
+			if err != nil {
+				return err
+			}
+			value, err = item.ValueCopy(nil)
+			return err
+		})
+		if err != nil {
+			panic(err)
+		}
+		return value
+	}
+
+	BeforeEach(func() {
+		dbDir, err := ioutil.TempDir("", "")
+		if err != nil {
+			panic(err)
+		}
+
+		db, err = badger.Open(
+			badger.DefaultOptions(dbDir).WithLogger(nil),
+		)
+		if err != nil {
+			panic(err)
+		}
+
+		keyring, err = nodekey.New(bytes.Repeat([]byte{1}, nodekey.KeySize))
+		if err != nil {
+			panic(err)
+		}
+	})
+
+	Context("AddAuth", func() {
+		It("should store auth encrypted", func() {
+			/* arrange */
+			objectUnderTest := mustNewStateStore(
+				context.Background(),
+				db,
+				keyring,
+				pubsub.New(db),
+			)
 
+			/* act */
+			actualErr := objectUnderTest.AddAuth(expectedAuth)
+
+			/* assert */
+			Expect(actualErr).To(BeNil())
+			Expect(string(storedAuthValue())).NotTo(ContainSubstring(expectedAuth.Password))
+			Expect(objectUnderTest.TryGetAuth(expectedAuth.Resources)).To(Equal(&expectedAuth))
+		})
+	})
+
+	Context("ListAuths", func() {
+		It("should return auths w/out passwords", func() {
+			/* arrange */
+			objectUnderTest := mustNewStateStore(
+				context.Background(),
+				db,
+				keyring,
+				pubsub.New(db),
+			)
+
+			if err := objectUnderTest.AddAuth(expectedAuth); err != nil {
+				panic(err)
+			}
+
+			/* act */
+			actualAuths := objectUnderTest.ListAuths()
+
+			/* assert */
+			Expect(actualAuths).To(Equal([]model.Auth{
+				{
+					Creds: model.Creds{
+						Username: expectedAuth.Username,
+					},
+					Resources: expectedAuth.Resources,
+				},
+			}))
+		})
+	})
+
+	Context("RemoveAuth", func() {
+		It("should remove auth", func() {
+			/* arrange */
+			objectUnderTest := mustNewStateStore(
+				context.Background(),
+				db,
+				keyring,
+				pubsub.New(db),
+			)
+
+			if err := objectUnderTest.AddAuth(expectedAuth); err != nil {
+				panic(err)
+			}
+
+			/* act */
+			actualErr := objectUnderTest.RemoveAuth("RESOURCES")
+
+			/* assert */
+			Expect(actualErr).To(BeNil())
+			Expect(objectUnderTest.ListAuths()).To(BeEmpty())
+		})
+	})
+
+	Context("AuthRemoved", func() {
+		It("should not remove auth", func() {
+			/* arrange */
+			pubSub := pubsub.New(db)
+
+			objectUnderTest := mustNewStateStore(
+				context.Background(),
+				db,
+				keyring,
+				pubSub,
+			)
+
+			if err := objectUnderTest.AddAuth(expectedAuth); err != nil {
+				panic(err)
+			}
+
+			/* act */
+			// replayed events must not undo later changes
+			pubSub.Publish(model.Event{
+				AuthRemoved: &model.AuthRemoved{
+					Resources: "RESOURCES",
+				},
+				Timestamp: time.Now().UTC(),
+			})
+
+			/* assert */
+			Consistently(
+				func() *model.Auth { return objectUnderTest.TryGetAuth(expectedAuth.Resources) },
+			).Should(
+				Equal(&expectedAuth),
+			)
+		})
+	})
+
+	Context("TryGetAuth", func() {
+		Context("multiple auths w/ matching resources", func() {
+			It("should return auth w/ longest resources", func() {
 				/* arrange */
-				providedReq := model.AddAuthReq{
+				objectUnderTest := mustNewStateStore(
+					context.Background(),
+					db,
+					keyring,
+					pubsub.New(db),
+				)
+
+				expectedAuth := model.Auth{
 					Creds: model.Creds{
-						Username: "username",
-						Password: "password",
+						Username: "org",
+						Password: "orgPassword",
 					},
-					Resources: "resources",
+					Resources: "docker.io/org",
 				}
 
-				dbDir, err := ioutil.TempDir("", "")
-				if err != nil {
-					panic(err)
+				for _, auth := range []model.Auth{
+					{
+						Creds: model.Creds{
+							Username: "host",
+							Password: "hostPassword",
+						},
+						Resources: "docker.io",
+					},
+					expectedAuth,
+					{
+						Creds: model.Creds{
+							Username: "other",
+							Password: "otherPassword",
+						},
+						Resources: "docker.io/org/image-other",
+					},
+				} {
+					if err := objectUnderTest.AddAuth(auth); err != nil {
+						panic(err)
+					}
 				}
 
-				db, err := badger.Open(
-					badger.DefaultOptions(dbDir).WithLogger(nil),
+				/* act */
+				actualAuth := objectUnderTest.TryGetAuth("docker.io/org/image")
+
+				/* assert */
+				Expect(actualAuth).To(Equal(&expectedAuth))
+			})
+		})
+		Context("AuthAdded w/ password", func() {
+			It("should return expected auth", func() {
+				/* arrange */
+				pubSub := pubsub.New(db)
+
+				// seed auth as published by prior versions
+				pubSub.Publish(model.Event{
+					AuthAdded: &model.AuthAdded{
+						Auth: expectedAuth,
+					},
+					Timestamp: time.Now().UTC(),
+				})
+
+				objectUnderTest := mustNewStateStore(
+					context.Background(),
+					db,
+					keyring,
+					pubSub,
 				)
+
+				/* act/assert */
+				Eventually(
+					func() *model.Auth { return objectUnderTest.TryGetAuth(expectedAuth.Resources) },
+				).Should(
+					Equal(&expectedAuth),
+				)
+				Expect(string(storedAuthValue())).NotTo(ContainSubstring(expectedAuth.Password))
+			})
+		})
+		Context("auth encrypted w/ unknown key", func() {
+			It("should return nil", func() {
+				/* arrange */
+				otherKeyring, err := nodekey.New(bytes.Repeat([]byte{2}, nodekey.KeySize))
 				if err != nil {
 					panic(err)
 				}
 
-				pubSub := pubsub.New(db)
-				eventChannel, err := pubSub.Subscribe(
+				if err := mustNewStateStore(
 					context.Background(),
-					model.EventFilter{},
+					db,
+					otherKeyring,
+					pubsub.New(db),
+				).AddAuth(expectedAuth); err != nil {
+					panic(err)
+				}
+
+				objectUnderTest := mustNewStateStore(
+					context.Background(),
+					db,
+					keyring,
+					pubsub.New(db),
+				)
+
+				/* act */
+				actualAuth := objectUnderTest.TryGetAuth(expectedAuth.Resources)
+
+				/* assert */
+				Expect(actualAuth).To(BeNil())
+			})
+		})
+	})
+
+	Context("newStateStore", func() {
+		Context("pubSub.RewriteEvents errs", func() {
+			It("should return expected error", func() {
+				/* arrange */
+				expectedErr := errors.New("expectedErr")
+
+				fakePubSub := new(FakePubSub)
+				fakePubSub.RewriteEventsReturns(expectedErr)
+
+				/* act */
+				_, actualErr := newStateStore(
+					context.Background(),
+					db,
+					keyring,
+					fakePubSub,
 				)
+
+				/* assert */
+				Expect(actualErr).To(Equal(expectedErr))
+			})
+		})
+		Context("auth stored in plaintext", func() {
+			It("should re-encrypt auth", func() {
+				/* arrange */
+				encodedAuth, err := json.Marshal(expectedAuth)
 				if err != nil {
 					panic(err)
 				}
 
-				expectedAuth := model.Auth{
-					Creds:     providedReq.Creds,
-					Resources: providedReq.Resources,
+				if err := db.Update(func(txn *badger.Txn) error {
+					return txn.Set([]byte("authsByResources_resources"), encodedAuth)
+				}); err != nil {
+					panic(err)
 				}
-				// seed auth
+
+				/* act */
+				objectUnderTest, actualErr := newStateStore(
+					context.Background(),
+					db,
+					keyring,
+					pubsub.New(db),
+				)
+
+				/* assert */
+				Expect(actualErr).To(BeNil())
+				Expect(string(storedAuthValue())).NotTo(ContainSubstring(expectedAuth.Password))
+				Expect(objectUnderTest.TryGetAuth(expectedAuth.Resources)).To(Equal(&expectedAuth))
+			})
+			Context("after first startup", func() {
+				It("should not accept auth", func() {
+					/* arrange */
+					mustNewStateStore(
+						context.Background(),
+						db,
+						keyring,
+						pubsub.New(db),
+					)
+
+					encodedAuth, err := json.Marshal(expectedAuth)
+					if err != nil {
+						panic(err)
+					}
+
+					if err := db.Update(func(txn *badger.Txn) error {
+						return txn.Set([]byte("authsByResources_resources"), encodedAuth)
+					}); err != nil {
+						panic(err)
+					}
+
+					/* act */
+					objectUnderTest, actualErr := newStateStore(
+						context.Background(),
+						db,
+						keyring,
+						pubsub.New(db),
+					)
+
+					/* assert */
+					Expect(actualErr).To(BeNil())
+					Expect(storedAuthValue()).To(Equal(encodedAuth))
+					Expect(objectUnderTest.TryGetAuth(expectedAuth.Resources)).To(BeNil())
+					Expect(objectUnderTest.ListAuths()).To(Equal([]model.Auth{
+						{Resources: expectedAuth.Resources},
+					}))
+				})
+			})
+		})
+		Context("AuthAdded event w/ password stored", func() {
+			It("should rewrite event w/out password", func() {
+				/* arrange */
+				pubSub := pubsub.New(db)
+
+				// seed event as published by prior versions
 				pubSub.Publish(model.Event{
 					AuthAdded: &model.AuthAdded{
 						Auth: expectedAuth,
@@ -60,35 +395,76 @@ var _ = Context("stateStore", func() {
 					Timestamp: time.Now().UTC(),
 				})
 
-				objectUnderTest := newStateStore(
+				/* act */
+				objectUnderTest, actualErr := newStateStore(
 					context.Background(),
 					db,
+					keyring,
 					pubSub,
 				)
 
-				// give stateStore time to receive & apply events
-				time.Sleep(time.Second)
+				/* assert */
+				Expect(actualErr).To(BeNil())
+				Expect(objectUnderTest.TryGetAuth(expectedAuth.Resources)).To(Equal(&expectedAuth))
+
+				since := time.Time{}
+				eventChannel, err := pubSub.Subscribe(
+					context.Background(),
+					model.EventFilter{Since: &since},
+				)
+				if err != nil {
+					panic(err)
+				}
+
+				actualEvent := <-eventChannel
+				Expect(*actualEvent.AuthAdded).To(Equal(model.AuthAdded{
+					Auth: model.Auth{
+						Creds: model.Creds{
+							Username: expectedAuth.Username,
+						},
+						Resources: expectedAuth.Resources,
+					},
+				}))
+			})
+		})
+		Context("auth encrypted w/ prior key", func() {
+			It("should re-encrypt auth w/ primary key", func() {
+				/* arrange */
+				if err := mustNewStateStore(
+					context.Background(),
+					db,
+					keyring,
+					pubsub.New(db),
+				).AddAuth(expectedAuth); err != nil {
+					panic(err)
+				}
+
+				primaryKey := bytes.Repeat([]byte{2}, nodekey.KeySize)
+				rotatedKeyring, err := nodekey.New(
+					primaryKey,
+					bytes.Repeat([]byte{1}, nodekey.KeySize),
+				)
+				if err != nil {
+					panic(err)
+				}
 
 				/* act */
-				objectUnderTest.TryGetAuth(
-					expectedAuth.Resources,
+				_, actualErr := newStateStore(
+					context.Background(),
+					db,
+					rotatedKeyring,
+					pubsub.New(db),
 				)
 
 				/* assert */
-				var actualAuth model.Auth
-				go func() {
-					for event := range eventChannel {
-						if event.AuthAdded != nil {
-							actualAuth = event.AuthAdded.Auth
-						}
-					}
-				}()
+				Expect(actualErr).To(BeNil())
+				primaryKeyring, err := nodekey.New(primaryKey)
+				if err != nil {
+					panic(err)
+				}
 
-				Eventually(
-					func() model.Auth { return actualAuth },
-				).Should(
-					Equal(expectedAuth),
-				)
+				_, _, actualDecryptErr := primaryKeyring.Decrypt(storedAuthValue())
+				Expect(actualDecryptErr).To(BeNil())
 			})
 		})
 	})
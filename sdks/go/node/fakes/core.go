@@ -62,6 +62,19 @@ type FakeNode struct {
 	killOpReturnsOnCall map[int]struct {
 		result1 error
 	}
+	ListAuthsStub        func(context.Context) ([]model.Auth, error)
+	listAuthsMutex       sync.RWMutex
+	listAuthsArgsForCall []struct {
+		arg1 context.Context
+	}
+	listAuthsReturns struct {
+		result1 []model.Auth
+		result2 error
+	}
+	listAuthsReturnsOnCall map[int]struct {
+		result1 []model.Auth
+		result2 error
+	}
 	ListDescendantsStub        func(context.Context, model.ListDescendantsReq) ([]*model.DirEntry, error)
 	listDescendantsMutex       sync.RWMutex
 	listDescendantsArgsForCall []struct {
@@ -87,6 +100,18 @@ type FakeNode struct {
 	livenessReturnsOnCall map[int]struct {
 		result1 error
 	}
+	RemoveAuthStub        func(context.Context, model.RemoveAuthReq) error
+	removeAuthMutex       sync.RWMutex
+	removeAuthArgsForCall []struct {
+		arg1 context.Context
+		arg2 model.RemoveAuthReq
+	}
+	removeAuthReturns struct {
+		result1 error
+	}
+	removeAuthReturnsOnCall map[int]struct {
+		result1 error
+	}
 	StartOpStub        func(context.Context, model.StartOpReq) (string, error)
 	startOpMutex       sync.RWMutex
 	startOpArgsForCall []struct {
@@ -355,6 +380,69 @@ func (fake *FakeNode) KillOpReturnsOnCall(i int, result1 error) {
 	}{result1}
 }
 
+func (fake *FakeNode) ListAuths(arg1 context.Context) ([]model.Auth, error) {
+	fake.listAuthsMutex.Lock()
+	ret, specificReturn := fake.listAuthsReturnsOnCall[len(fake.listAuthsArgsForCall)]
+	fake.listAuthsArgsForCall = append(fake.listAuthsArgsForCall, struct {
+		arg1 context.Context
+	}{arg1})
+	fake.recordInvocation("ListAuths", []interface{}{arg1})
+	fake.listAuthsMutex.Unlock()
+	if fake.ListAuthsStub != nil {
+		return fake.ListAuthsStub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	fakeReturns := fake.listAuthsReturns
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeNode) ListAuthsCallCount() int {
+	fake.listAuthsMutex.RLock()
+	defer fake.listAuthsMutex.RUnlock()
+	return len(fake.listAuthsArgsForCall)
+}
+
+func (fake *FakeNode) ListAuthsCalls(stub func(context.Context) ([]model.Auth, error)) {
+	fake.listAuthsMutex.Lock()
+	defer fake.listAuthsMutex.Unlock()
+	fake.ListAuthsStub = stub
+}
+
+func (fake *FakeNode) ListAuthsArgsForCall(i int) context.Context {
+	fake.listAuthsMutex.RLock()
+	defer fake.listAuthsMutex.RUnlock()
+	argsForCall := fake.listAuthsArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeNode) ListAuthsReturns(result1 []model.Auth, result2 error) {
+	fake.listAuthsMutex.Lock()
+	defer fake.listAuthsMutex.Unlock()
+	fake.ListAuthsStub = nil
+	fake.listAuthsReturns = struct {
+		result1 []model.Auth
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeNode) ListAuthsReturnsOnCall(i int, result1 []model.Auth, result2 error) {
+	fake.listAuthsMutex.Lock()
+	defer fake.listAuthsMutex.Unlock()
+	fake.ListAuthsStub = nil
+	if fake.listAuthsReturnsOnCall == nil {
+		fake.listAuthsReturnsOnCall = make(map[int]struct {
+			result1 []model.Auth
+			result2 error
+		})
+	}
+	fake.listAuthsReturnsOnCall[i] = struct {
+		result1 []model.Auth
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeNode) ListDescendants(arg1 context.Context, arg2 model.ListDescendantsReq) ([]*model.DirEntry, error) {
 	fake.listDescendantsMutex.Lock()
 	ret, specificReturn := fake.listDescendantsReturnsOnCall[len(fake.listDescendantsArgsForCall)]
@@ -479,6 +567,67 @@ func (fake *FakeNode) LivenessReturnsOnCall(i int, result1 error) {
 	}{result1}
 }
 
+func (fake *FakeNode) RemoveAuth(arg1 context.Context, arg2 model.RemoveAuthReq) error {
+	fake.removeAuthMutex.Lock()
+	ret, specificReturn := fake.removeAuthReturnsOnCall[len(fake.removeAuthArgsForCall)]
+	fake.removeAuthArgsForCall = append(fake.removeAuthArgsForCall, struct {
+		arg1 context.Context
+		arg2 model.RemoveAuthReq
+	}{arg1, arg2})
+	fake.recordInvocation("RemoveAuth", []interface{}{arg1, arg2})
+	fake.removeAuthMutex.Unlock()
+	if fake.RemoveAuthStub != nil {
+		return fake.RemoveAuthStub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.removeAuthReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeNode) RemoveAuthCallCount() int {
+	fake.removeAuthMutex.RLock()
+	defer fake.removeAuthMutex.RUnlock()
+	return len(fake.removeAuthArgsForCall)
+}
+
+func (fake *FakeNode) RemoveAuthCalls(stub func(context.Context, model.RemoveAuthReq) error) {
+	fake.removeAuthMutex.Lock()
+	defer fake.removeAuthMutex.Unlock()
+	fake.RemoveAuthStub = stub
+}
+
+func (fake *FakeNode) RemoveAuthArgsForCall(i int) (context.Context, model.RemoveAuthReq) {
+	fake.removeAuthMutex.RLock()
+	defer fake.removeAuthMutex.RUnlock()
+	argsForCall := fake.removeAuthArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeNode) RemoveAuthReturns(result1 error) {
+	fake.removeAuthMutex.Lock()
+	defer fake.removeAuthMutex.Unlock()
+	fake.RemoveAuthStub = nil
+	fake.removeAuthReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeNode) RemoveAuthReturnsOnCall(i int, result1 error) {
+	fake.removeAuthMutex.Lock()
+	defer fake.removeAuthMutex.Unlock()
+	fake.RemoveAuthStub = nil
+	if fake.removeAuthReturnsOnCall == nil {
+		fake.removeAuthReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.removeAuthReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
 func (fake *FakeNode) StartOp(arg1 context.Context, arg2 model.StartOpReq) (string, error) {
 	fake.startOpMutex.Lock()
 	ret, specificReturn := fake.startOpReturnsOnCall[len(fake.startOpArgsForCall)]
@@ -554,10 +703,14 @@ func (fake *FakeNode) Invocations() map[string][][]interface{} {
 	defer fake.getEventStreamMutex.RUnlock()
 	fake.killOpMutex.RLock()
 	defer fake.killOpMutex.RUnlock()
+	fake.listAuthsMutex.RLock()
+	defer fake.listAuthsMutex.RUnlock()
 	fake.listDescendantsMutex.RLock()
 	defer fake.listDescendantsMutex.RUnlock()
 	fake.livenessMutex.RLock()
 	defer fake.livenessMutex.RUnlock()
+	fake.removeAuthMutex.RLock()
+	defer fake.removeAuthMutex.RUnlock()
 	fake.startOpMutex.RLock()
 	defer fake.startOpMutex.RUnlock()
 	copiedInvocations := map[string][][]interface{}{}
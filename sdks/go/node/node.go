@@ -18,6 +18,20 @@ type Node interface {
 		req model.AddAuthReq,
 	) error
 
+	// ListAuths lists auth recorded within the core; passwords are omitted
+	ListAuths(
+		ctx context.Context,
+	) (
+		[]model.Auth,
+		error,
+	)
+
+	// RemoveAuth removes auth recorded within the core
+	RemoveAuth(
+		ctx context.Context,
+		req model.RemoveAuthReq,
+	) error
+
 	GetEventStream(
 		ctx context.Context,
 		req *model.GetEventStreamReq,
@@ -0,0 +1,89 @@
+package dryrun
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+
+	"github.com/opctl/opctl/sdks/go/internal/uniquestring"
+	"github.com/opctl/opctl/sdks/go/model"
+	"github.com/opctl/opctl/sdks/go/opspec/interpreter/call/op"
+)
+
+// Call is a call a run would make
+type Call struct {
+	// Path locates the call within its op i.e. run.serial[1].parallel[0];
+	// calls of child ops are prefixed by the path & ref of the op call i.e. run.serial[0] > ../build > run
+	Path string
+	// Container is the container call w/ secrets masked; nil if the call couldn't be interpreted
+	Container *model.ContainerCall
+	// Pending is the names of the values, not known until output by prior calls, the call depends on;
+	// such values are marked <pending $(NAME)>
+	Pending []string
+	// Err is why the call couldn't be interpreted; nil if it could.
+	// Calls depending on pending values they can't be interpreted w/out (i.e. an if predicate or loop range) err
+	Err error
+}
+
+// Interpret interprets the container calls a run of the op at opPath w/ args would make, in the order they'd be made
+// (calls made in parallel are ordered as declared), w/out making them.
+//
+// Child ops are resolved from the filesystem (relative to their parent) & then providers;
+// scratch files created within dataDirPath while interpreting are removed.
+func Interpret(
+	ctx context.Context,
+	opPath string,
+	args map[string]*model.Value,
+	dataDirPath string,
+	providers ...model.DataProvider,
+) ([]*Call, error) {
+	rootCallID, err := uniquestring.Construct()
+	if err != nil {
+		return nil, err
+	}
+
+	i := &interpreter{
+		ctx:         ctx,
+		callIDs:     []string{rootCallID},
+		dataDirPath: dataDirPath,
+		providers:   providers,
+		rootCallID:  rootCallID,
+	}
+	defer func() {
+		for _, callID := range i.callIDs {
+			os.RemoveAll(filepath.Join(dataDirPath, "dcg", callID))
+		}
+	}()
+
+	// call the op the same way a node starts it; args are implicitly bound to inputs
+	opCallSpec := &model.OpCallSpec{
+		Ref:    opPath,
+		Inputs: map[string]interface{}{},
+	}
+	for name := range args {
+		opCallSpec.Inputs[name] = ""
+	}
+
+	opCall, err := op.Interpret(
+		ctx,
+		args,
+		opCallSpec,
+		rootCallID,
+		opPath,
+		dataDirPath,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err := i.interpretOp(
+		opCall,
+		opCallSpec,
+		"",
+		nil,
+	); err != nil {
+		return nil, err
+	}
+
+	return i.calls, nil
+}
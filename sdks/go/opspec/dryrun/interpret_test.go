@@ -0,0 +1,110 @@
+package dryrun
+
+import (
+	"context"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/opctl/opctl/sdks/go/model"
+)
+
+var _ = Context("Interpret", func() {
+	wd, err := os.Getwd()
+	if err != nil {
+		panic(err)
+	}
+
+	var dataDirPath string
+	BeforeEach(func() {
+		dataDirPath, err = ioutil.TempDir("", "")
+		if err != nil {
+			panic(err)
+		}
+	})
+	AfterEach(func() {
+		os.RemoveAll(dataDirPath)
+	})
+
+	It("should return expected calls", func() {
+		/* arrange */
+		token := "s3cr3t"
+
+		/* act */
+		actualCalls, actualErr := Interpret(
+			context.Background(),
+			filepath.Join(wd, "testdata/parent"),
+			map[string]*model.Value{
+				"token": {String: &token},
+			},
+			dataDirPath,
+		)
+
+		/* assert */
+		Expect(actualErr).To(BeNil())
+		Expect(actualCalls).To(HaveLen(5))
+
+		Expect(actualCalls[0].Path).To(Equal("run.serial[0]"))
+		Expect(*actualCalls[0].Container.Image.Ref).To(Equal("docker.io/library/alpine:3.12"))
+		Expect(actualCalls[0].Container.Cmd).To(Equal([]string{"echo", "hi"}))
+		Expect(actualCalls[0].Container.EnvVars).To(Equal(map[string]string{"TOKEN": "***"}))
+		Expect(actualCalls[0].Pending).To(BeEmpty())
+
+		Expect(actualCalls[1].Path).To(Equal("run.serial[1]"))
+		Expect(actualCalls[1].Container.Files).To(Equal(map[string]string{"/in.txt": "<pending $(out)>"}))
+		Expect(actualCalls[1].Pending).To(Equal([]string{"out"}))
+
+		Expect(actualCalls[2].Path).To(Equal("run.serial[2] > ../child > run"))
+		Expect(actualCalls[2].Container.Cmd).To(Equal([]string{"sh", "-c", "echo ***"}))
+
+		Expect(actualCalls[3].Path).To(Equal("run.serial[3]"))
+		Expect(actualCalls[3].Container.Cmd).To(Equal([]string{"echo", "<pending $(version)>"}))
+		Expect(actualCalls[3].Pending).To(Equal([]string{"version"}))
+
+		Expect(actualCalls[4].Path).To(Equal("run.serial[4]"))
+		Expect(actualCalls[4].Container).To(BeNil())
+		Expect(actualCalls[4].Pending).To(Equal([]string{"version"}))
+		Expect(actualCalls[4].Err).To(MatchError("unable to interpret until prior calls produce outputs"))
+	})
+	It("should interpret loop iterations", func() {
+		/* act */
+		actualCalls, actualErr := Interpret(
+			context.Background(),
+			filepath.Join(wd, "testdata/loops"),
+			map[string]*model.Value{},
+			dataDirPath,
+		)
+
+		/* assert */
+		Expect(actualErr).To(BeNil())
+		Expect(actualCalls).To(HaveLen(4))
+
+		Expect(actualCalls[0].Path).To(Equal("run.serial[0].parallelLoop[0]"))
+		Expect(actualCalls[0].Container.Cmd).To(Equal([]string{"echo", "a"}))
+		Expect(actualCalls[1].Path).To(Equal("run.serial[0].parallelLoop[1]"))
+		Expect(actualCalls[1].Container.Cmd).To(Equal([]string{"echo", "b"}))
+
+		Expect(actualCalls[2].Path).To(Equal("run.serial[1].serialLoop[0]"))
+		Expect(actualCalls[2].Err).To(BeNil())
+
+		// whether to iterate again depends on the output of the first iteration
+		Expect(actualCalls[3].Path).To(Equal("run.serial[1].serialLoop[1]"))
+		Expect(actualCalls[3].Pending).To(Equal([]string{"done"}))
+		Expect(actualCalls[3].Err).To(MatchError("unable to interpret until prior calls produce outputs"))
+	})
+	It("should remove scratch files", func() {
+		/* act */
+		_, actualErr := Interpret(
+			context.Background(),
+			filepath.Join(wd, "testdata/loops"),
+			map[string]*model.Value{},
+			dataDirPath,
+		)
+
+		/* assert */
+		Expect(actualErr).To(BeNil())
+		Expect(ioutil.ReadDir(filepath.Join(dataDirPath, "dcg"))).To(BeEmpty())
+	})
+})
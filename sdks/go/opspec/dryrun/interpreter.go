@@ -0,0 +1,552 @@
+package dryrun
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"path/filepath"
+	"regexp"
+
+	"github.com/opctl/opctl/sdks/go/data"
+	"github.com/opctl/opctl/sdks/go/data/fs"
+	"github.com/opctl/opctl/sdks/go/internal/redact"
+	"github.com/opctl/opctl/sdks/go/internal/uniquestring"
+	"github.com/opctl/opctl/sdks/go/model"
+	"github.com/opctl/opctl/sdks/go/opspec"
+	callpkg "github.com/opctl/opctl/sdks/go/opspec/interpreter/call"
+	"github.com/opctl/opctl/sdks/go/opspec/interpreter/call/dag"
+	"github.com/opctl/opctl/sdks/go/opspec/interpreter/call/loop"
+	"github.com/opctl/opctl/sdks/go/opspec/interpreter/call/loop/iteration"
+	"github.com/opctl/opctl/sdks/go/opspec/interpreter/call/parallelloop"
+	"github.com/opctl/opctl/sdks/go/opspec/interpreter/call/serialloop"
+	"github.com/opctl/opctl/sdks/go/opspec/opfile"
+)
+
+var errPending = errors.New("unable to interpret until prior calls produce outputs")
+
+// interpreter interprets calls the way a node's callers would call them; outputs of calls are pending values
+type interpreter struct {
+	ctx context.Context
+	// callIDs is the ids of interpreted calls; their scratch dirs are removed once done
+	callIDs     []string
+	calls       []*Call
+	dataDirPath string
+	// providers resolve child ops; they're resolved before interpretation so they aren't pulled into dataDirPath
+	providers  []model.DataProvider
+	rootCallID string
+}
+
+func (i *interpreter) newCallID() (string, error) {
+	callID, err := uniquestring.Construct()
+	if err != nil {
+		return "", err
+	}
+	i.callIDs = append(i.callIDs, callID)
+	return callID, nil
+}
+
+// interpret interprets callSpec & its descendants; returns the (pending) outputs of the call.
+// Calls which can't be interpreted are recorded w/ an error; errors returned abort interpretation
+func (i *interpreter) interpret(
+	scope map[string]*model.Value,
+	callSpec *model.CallSpec,
+	path string,
+	opPath string,
+	parentCallID *string,
+	secrets []string,
+) (map[string]*model.Value, error) {
+	if pending := pendingNames(scope, controlSpecs(callSpec)); len(pending) > 0 {
+		// whether or how the call is made depends on outputs of prior calls
+		i.calls = append(i.calls, &Call{Path: path, Pending: pending, Err: errPending})
+		return nil, nil
+	}
+
+	callID, err := i.newCallID()
+	if err != nil {
+		return nil, err
+	}
+
+	resolvedCallSpec, err := i.resolveOpRef(callSpec, opPath)
+	if err != nil {
+		i.calls = append(i.calls, &Call{Path: path, Err: err})
+		return nil, nil
+	}
+
+	call, err := callpkg.Interpret(
+		i.ctx,
+		scope,
+		resolvedCallSpec,
+		callID,
+		opPath,
+		parentCallID,
+		i.rootCallID,
+		i.dataDirPath,
+	)
+	if err != nil {
+		i.calls = append(i.calls, &Call{Path: path, Pending: pendingNames(scope, callSpec), Err: err})
+		return nil, nil
+	}
+
+	if call.If != nil && !*call.If {
+		if callSpec.Else != nil {
+			return i.interpret(scope, callSpec.Else, path+".else", opPath, parentCallID, secrets)
+		}
+		return nil, nil
+	}
+
+	switch {
+	case call.Container != nil:
+		i.calls = append(
+			i.calls,
+			&Call{
+				Path:      path,
+				Container: redactContainerCall(*call.Container, secrets),
+				Pending:   pendingNames(scope, []interface{}{callSpec, call.Container}),
+			},
+		)
+		return containerOutputs(callSpec.Container), nil
+	case call.Dag != nil:
+		return i.interpretDag(scope, call.Dag, path, opPath, &callID, secrets)
+	case call.Op != nil:
+		return i.interpretOp(call.Op, callSpec.Op, path, secrets)
+	case call.Parallel != nil:
+		outputs := map[string]*model.Value{}
+		for childIndex, childCallSpec := range call.Parallel {
+			childOutputs, err := i.interpret(scope, childCallSpec, fmt.Sprintf("%v.parallel[%v]", path, childIndex), opPath, &callID, secrets)
+			if err != nil {
+				return nil, err
+			}
+			merge(outputs, childOutputs)
+		}
+		return outputs, nil
+	case call.ParallelLoop != nil:
+		return i.interpretParallelLoop(scope, *callSpec.ParallelLoop, path, opPath, &callID, secrets)
+	case call.Serial != nil:
+		return i.interpretSerial(scope, call.Serial, callSpec.Finally, path, opPath, &callID, secrets)
+	case call.SerialLoop != nil:
+		return i.interpretSerialLoop(scope, *callSpec.SerialLoop, path, opPath, &callID, secrets)
+	case call.Switch != nil:
+		if call.Switch.Run == nil {
+			return nil, nil
+		}
+
+		switchPath := fmt.Sprintf("%v.switch.default", path)
+		if call.Switch.Case != nil {
+			switchPath = fmt.Sprintf("%v.switch.cases[%v].run", path, *call.Switch.Case)
+		}
+		return i.interpret(scope, call.Switch.Run, switchPath, opPath, &callID, secrets)
+	}
+
+	return nil, nil
+}
+
+// interpretOp interprets the child call & finally of an op call; returns the outputs bound by opCallSpec
+func (i *interpreter) interpretOp(
+	opCall *model.OpCall,
+	opCallSpec *model.OpCallSpec,
+	path string,
+	secrets []string,
+) (map[string]*model.Value, error) {
+	secrets = append(append([]string{}, secrets...), opCall.Secrets...)
+
+	// form scope for op call by combining defined inputs & op dir
+	opCallScope := map[string]*model.Value{}
+	merge(opCallScope, opCall.Inputs)
+	opCallScope["/"] = &model.Value{Dir: &opCall.OpPath}
+	opCallScope["./"] = &model.Value{Dir: &opCall.OpPath}
+	parentDirPath := filepath.Dir(opCall.OpPath)
+	opCallScope["../"] = &model.Value{Dir: &parentDirPath}
+
+	opFile, err := opfile.Get(i.ctx, opCall.OpPath)
+	if err != nil {
+		return nil, err
+	}
+
+	// calls of child ops are prefixed by the path & ref of the op call
+	pathPrefix := ""
+	if path != "" {
+		pathPrefix = fmt.Sprintf("%v > %v > ", path, opCallSpec.Ref)
+	}
+
+	finallyScope := map[string]*model.Value{}
+	merge(finallyScope, opCallScope)
+
+	if opCall.ChildCallCallSpec != nil {
+		opOutputs, err := i.interpret(opCallScope, opCall.ChildCallCallSpec, pathPrefix+"run", opCall.OpPath, &opCall.OpID, secrets)
+		if err != nil {
+			return nil, err
+		}
+		merge(finallyScope, opOutputs)
+	}
+
+	if opFile.Finally != nil {
+		if _, err := i.interpret(finallyScope, opFile.Finally, pathPrefix+"finally", opCall.OpPath, &opCall.OpID, secrets); err != nil {
+			return nil, err
+		}
+	}
+
+	outputs := map[string]*model.Value{}
+	for boundName, boundValue := range opCallSpec.Outputs {
+		if boundValue == "" {
+			// implicit value
+			boundValue = boundName
+		} else if !regexp.MustCompile("^\\$\\(.+\\)$").MatchString(boundValue) {
+			// handle obsolete syntax by swapping order
+			boundName, boundValue = boundValue, boundName
+		} else {
+			boundValue = opspec.RefToName(boundValue)
+		}
+		outputs[boundValue] = pendingValue(boundValue, opFile.Outputs[boundName])
+	}
+
+	return outputs, nil
+}
+
+// resolveOpRef returns a copy of callSpec w/ the ref of its op call (if any) replaced by the path it resolves to
+func (i *interpreter) resolveOpRef(
+	callSpec *model.CallSpec,
+	opPath string,
+) (*model.CallSpec, error) {
+	if callSpec.Op == nil || regexp.MustCompile("^\\$\\(.+\\)$").MatchString(callSpec.Op.Ref) {
+		return callSpec, nil
+	}
+
+	opHandle, err := data.Resolve(
+		i.ctx,
+		callSpec.Op.Ref,
+		append(
+			[]model.DataProvider{fs.New(opPath, filepath.Dir(opPath))},
+			i.providers...,
+		)...,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	resolvedOpPath := opHandle.Path()
+	if resolvedOpPath == nil {
+		return nil, fmt.Errorf("unable to interpret op '%v': op not available locally", callSpec.Op.Ref)
+	}
+
+	resolvedOpCallSpec := *callSpec.Op
+	resolvedOpCallSpec.Ref = *resolvedOpPath
+
+	resolvedCallSpec := *callSpec
+	resolvedCallSpec.Op = &resolvedOpCallSpec
+	return &resolvedCallSpec, nil
+}
+
+func (i *interpreter) interpretSerial(
+	scope map[string]*model.Value,
+	callSpecs []*model.CallSpec,
+	finallyCallSpec *model.CallSpec,
+	path string,
+	opPath string,
+	parentCallID *string,
+	secrets []string,
+) (map[string]*model.Value, error) {
+	childScope := map[string]*model.Value{}
+	merge(childScope, scope)
+	outputs := map[string]*model.Value{}
+
+	for childIndex, childCallSpec := range callSpecs {
+		childOutputs, err := i.interpret(childScope, childCallSpec, fmt.Sprintf("%v.serial[%v]", path, childIndex), opPath, parentCallID, secrets)
+		if err != nil {
+			return nil, err
+		}
+		merge(childScope, childOutputs)
+		merge(outputs, childOutputs)
+	}
+
+	if finallyCallSpec != nil {
+		finallyOutputs, err := i.interpret(childScope, finallyCallSpec, path+".finally", opPath, parentCallID, secrets)
+		if err != nil {
+			return nil, err
+		}
+		merge(outputs, finallyOutputs)
+	}
+
+	return outputs, nil
+}
+
+// interpretDag interprets the children of a dag call in an order they could be called in, needed calls first
+func (i *interpreter) interpretDag(
+	scope map[string]*model.Value,
+	callSpecs []*model.CallSpec,
+	path string,
+	opPath string,
+	parentCallID *string,
+	secrets []string,
+) (map[string]*model.Value, error) {
+	neededIndicesByIndex, err := dag.Needs(callSpecs)
+	if err != nil {
+		i.calls = append(i.calls, &Call{Path: path, Err: err})
+		return nil, nil
+	}
+
+	childOutputsByIndex := make([]map[string]*model.Value, len(callSpecs))
+	isInterpretedByIndex := make([]bool, len(callSpecs))
+	outputs := map[string]*model.Value{}
+
+	for interpretedCount := 0; interpretedCount < len(callSpecs); {
+		for childIndex, childCallSpec := range callSpecs {
+			if isInterpretedByIndex[childIndex] {
+				continue
+			}
+
+			isReady := true
+			for _, neededIndex := range neededIndicesByIndex[childIndex] {
+				isReady = isReady && isInterpretedByIndex[neededIndex]
+			}
+			if !isReady {
+				continue
+			}
+
+			// scope children w/ the outputs of the calls they need (directly or transitively)
+			childScope := map[string]*model.Value{}
+			merge(childScope, scope)
+			for _, ancestorIndex := range dag.Ancestors(neededIndicesByIndex, childIndex) {
+				merge(childScope, childOutputsByIndex[ancestorIndex])
+			}
+
+			childOutputs, err := i.interpret(childScope, childCallSpec, fmt.Sprintf("%v.dag[%v]", path, childIndex), opPath, parentCallID, secrets)
+			if err != nil {
+				return nil, err
+			}
+			childOutputsByIndex[childIndex] = childOutputs
+			merge(outputs, childOutputs)
+
+			isInterpretedByIndex[childIndex] = true
+			interpretedCount++
+		}
+	}
+
+	return outputs, nil
+}
+
+func (i *interpreter) interpretParallelLoop(
+	scope map[string]*model.Value,
+	callSpecParallelLoop model.ParallelLoopCallSpec,
+	path string,
+	opPath string,
+	parentCallID *string,
+	secrets []string,
+) (map[string]*model.Value, error) {
+	loopRangeSpec, err := parallelloop.RangeSpec(
+		callSpecParallelLoop,
+		scope,
+	)
+	if err != nil {
+		i.calls = append(i.calls, &Call{Path: path, Err: err})
+		return nil, nil
+	}
+
+	outputs := map[string]*model.Value{}
+	for childIndex := 0; ; childIndex++ {
+		childScope, err := iteration.Scope(
+			childIndex,
+			scope,
+			loopRangeSpec,
+			callSpecParallelLoop.Vars,
+		)
+		if err == nil {
+			var callParallelLoop *model.ParallelLoopCall
+			callParallelLoop, err = parallelloop.Interpret(
+				callSpecParallelLoop,
+				childScope,
+			)
+			if err == nil && parallelloop.IsIterationComplete(childIndex, *callParallelLoop) {
+				break
+			}
+		}
+		if err != nil {
+			i.calls = append(i.calls, &Call{Path: path, Err: err})
+			return nil, nil
+		}
+
+		childOutputs, err := i.interpret(childScope, &callSpecParallelLoop.Run, fmt.Sprintf("%v.parallelLoop[%v]", path, childIndex), opPath, parentCallID, secrets)
+		if err != nil {
+			return nil, err
+		}
+		merge(outputs, childOutputs)
+	}
+
+	return collectedOutputs(
+		loop.DeScope(scope, loopRangeSpec, callSpecParallelLoop.Vars, outputs),
+		callSpecParallelLoop.Collect,
+	), nil
+}
+
+func (i *interpreter) interpretSerialLoop(
+	scope map[string]*model.Value,
+	callSpecSerialLoop model.SerialLoopCallSpec,
+	path string,
+	opPath string,
+	parentCallID *string,
+	secrets []string,
+) (map[string]*model.Value, error) {
+	loopRangeSpec, err := serialloop.RangeSpec(
+		callSpecSerialLoop,
+		scope,
+	)
+	if err != nil {
+		i.calls = append(i.calls, &Call{Path: path, Err: err})
+		return nil, nil
+	}
+
+	childScope := map[string]*model.Value{}
+	merge(childScope, scope)
+	outputs := map[string]*model.Value{}
+
+	for childIndex := 0; ; childIndex++ {
+		if pending := pendingNames(childScope, callSpecSerialLoop.Until); len(pending) > 0 {
+			// whether to iterate again depends on outputs of prior iterations
+			i.calls = append(i.calls, &Call{Path: fmt.Sprintf("%v.serialLoop[%v]", path, childIndex), Pending: pending, Err: errPending})
+			break
+		}
+
+		var err error
+		childScope, err = iteration.Scope(
+			childIndex,
+			childScope,
+			loopRangeSpec,
+			callSpecSerialLoop.Vars,
+		)
+		if err == nil {
+			var callSerialLoop *model.SerialLoopCall
+			callSerialLoop, err = serialloop.Interpret(
+				callSpecSerialLoop,
+				childScope,
+			)
+			if err == nil && serialloop.IsIterationComplete(childIndex, callSerialLoop) {
+				break
+			}
+			if err == nil && callSerialLoop.MaxIterations != nil && childIndex >= *callSerialLoop.MaxIterations {
+				err = fmt.Errorf("serial loop not done after maxIterations (%v) iterations", *callSerialLoop.MaxIterations)
+			}
+		}
+		if err != nil {
+			i.calls = append(i.calls, &Call{Path: path, Err: err})
+			return nil, nil
+		}
+
+		childOutputs, err := i.interpret(childScope, &callSpecSerialLoop.Run, fmt.Sprintf("%v.serialLoop[%v]", path, childIndex), opPath, parentCallID, secrets)
+		if err != nil {
+			return nil, err
+		}
+		merge(childScope, childOutputs)
+		merge(outputs, childOutputs)
+	}
+
+	return collectedOutputs(
+		loop.DeScope(scope, loopRangeSpec, callSpecSerialLoop.Vars, outputs),
+		callSpecSerialLoop.Collect,
+	), nil
+}
+
+// controlSpecs returns the parts of callSpec which determine whether or how often its calls are made
+func controlSpecs(
+	callSpec *model.CallSpec,
+) []interface{} {
+	controlSpecs := []interface{}{callSpec.If}
+	if callSpec.Op != nil {
+		controlSpecs = append(controlSpecs, callSpec.Op.Ref)
+	}
+	if callSpec.ParallelLoop != nil {
+		controlSpecs = append(controlSpecs, callSpec.ParallelLoop.Range, callSpec.ParallelLoop.Matrix)
+	}
+	if callSpec.SerialLoop != nil {
+		controlSpecs = append(controlSpecs, callSpec.SerialLoop.Range, callSpec.SerialLoop.Matrix)
+	}
+	if callSpec.Switch != nil {
+		for _, switchCase := range callSpec.Switch.Cases {
+			controlSpecs = append(controlSpecs, switchCase.If)
+		}
+	}
+	return controlSpecs
+}
+
+// containerOutputs returns the (pending) outputs of a container call; see containerCaller.interpretOutputs of the node
+func containerOutputs(
+	containerCallSpec *model.ContainerCallSpec,
+) map[string]*model.Value {
+	outputs := map[string]*model.Value{}
+
+	for socketAddr, name := range containerCallSpec.Sockets {
+		if "0.0.0.0" == socketAddr {
+			outputs[name] = pendingValue(name, &model.Param{Socket: &model.SocketParam{}})
+		}
+	}
+	for _, mountSrc := range containerCallSpec.Files {
+		if mountSrcStr, ok := mountSrc.(string); ok && mountSrcStr != "" {
+			name := opspec.RefToName(mountSrcStr)
+			outputs[name] = pendingValue(name, &model.Param{File: &model.FileParam{}})
+		}
+	}
+	for _, mountSrc := range containerCallSpec.Dirs {
+		if mountSrcStr, ok := mountSrc.(string); ok && mountSrcStr != "" {
+			name := opspec.RefToName(mountSrcStr)
+			outputs[name] = pendingValue(name, &model.Param{Dir: &model.DirParam{}})
+		}
+	}
+
+	return outputs
+}
+
+// collectedOutputs adds outputs collected by a loop to outputs
+func collectedOutputs(
+	outputs map[string]*model.Value,
+	collectSpec []string,
+) map[string]*model.Value {
+	for _, outputRef := range collectSpec {
+		name := opspec.RefToName(outputRef)
+		outputs[name] = pendingValue(name, nil)
+	}
+	return outputs
+}
+
+// redactContainerCall returns a copy of containerCall w/ secrets & creds replaced w/ a mask
+func redactContainerCall(
+	containerCall model.ContainerCall,
+	secrets []string,
+) *model.ContainerCall {
+	redactor := redact.New(secrets)
+
+	if containerCall.Cmd != nil {
+		cmd := []string{}
+		for _, arg := range containerCall.Cmd {
+			cmd = append(cmd, redactor.RedactString(arg))
+		}
+		containerCall.Cmd = cmd
+	}
+
+	if containerCall.EnvVars != nil {
+		envVars := map[string]string{}
+		for name, value := range containerCall.EnvVars {
+			envVars[name] = redactor.RedactString(value)
+		}
+		containerCall.EnvVars = envVars
+	}
+
+	if containerCall.Image != nil {
+		image := *containerCall.Image
+		if image.PullCreds != nil {
+			image.PullCreds = &model.Creds{
+				Username: image.PullCreds.Username,
+				Password: redact.Mask,
+			}
+		}
+		containerCall.Image = &image
+	}
+
+	return &containerCall
+}
+
+func merge(
+	dst map[string]*model.Value,
+	src map[string]*model.Value,
+) {
+	for name, value := range src {
+		dst[name] = value
+	}
+}
@@ -0,0 +1,97 @@
+package dryrun
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/opctl/opctl/sdks/go/model"
+)
+
+// pendingRegexp matches the marks of values pending output by prior calls; submatch 1 is the name of the value
+var pendingRegexp = regexp.MustCompile(`<pending \$\(([^)<>]+)\)>`)
+
+// pendingMark marks a value which isn't known until output by a prior call
+func pendingMark(
+	name string,
+) string {
+	return fmt.Sprintf("<pending $(%v)>", name)
+}
+
+// pendingValue returns a value standing in for the value of name until output by a prior call.
+// dirs, files & sockets are marked in place of their path; all else in place of a string
+func pendingValue(
+	name string,
+	param *model.Param,
+) *model.Value {
+	mark := pendingMark(name)
+	switch {
+	case param != nil && param.Dir != nil:
+		return &model.Value{Dir: &mark}
+	case param != nil && param.File != nil:
+		return &model.Value{File: &mark}
+	case param != nil && param.Socket != nil:
+		return &model.Value{Socket: &mark}
+	default:
+		return &model.Value{String: &mark}
+	}
+}
+
+// pendingName returns the name of the value value stands in for; empty if value isn't pending
+func pendingName(
+	value *model.Value,
+) string {
+	if value == nil {
+		return ""
+	}
+
+	for _, str := range []*string{value.Dir, value.File, value.Socket, value.String} {
+		if str != nil {
+			if match := pendingRegexp.FindStringSubmatch(*str); match != nil {
+				return match[1]
+			}
+		}
+	}
+	return ""
+}
+
+// pendingNames returns the names of the pending values marked in, or referenced by, v; sorted
+func pendingNames(
+	scope map[string]*model.Value,
+	v interface{},
+) []string {
+	// marks must not be escaped
+	vBuffer := bytes.Buffer{}
+	encoder := json.NewEncoder(&vBuffer)
+	encoder.SetEscapeHTML(false)
+	if err := encoder.Encode(v); err != nil {
+		return nil
+	}
+	vString := vBuffer.String()
+
+	isPending := map[string]bool{}
+	for _, match := range pendingRegexp.FindAllStringSubmatch(vString, -1) {
+		isPending[match[1]] = true
+	}
+
+	for name, value := range scope {
+		if pendingName := pendingName(value); pendingName != "" {
+			for _, terminator := range []string{")", ".", "/", "["} {
+				if strings.Contains(vString, "$("+name+terminator) {
+					isPending[pendingName] = true
+				}
+			}
+		}
+	}
+
+	names := []string{}
+	for name := range isPending {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	return names
+}
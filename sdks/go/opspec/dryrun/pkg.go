@@ -0,0 +1,2 @@
+// Package dryrun exposes functionality for interpreting the calls a run of an op would make w/out making them.
+package dryrun
@@ -0,0 +1,58 @@
+package graph
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/opctl/opctl/sdks/go/model"
+)
+
+// fillColorByOutcome is the fill color of calls by their outcome
+var fillColorByOutcome = map[string]string{
+	model.OpOutcomeFailed:    "lightpink",
+	model.OpOutcomeKilled:    "lightgrey",
+	model.OpOutcomeSucceeded: "palegreen",
+}
+
+// DOT renders a graph in the DOT language of Graphviz; see https://graphviz.org/doc/info/lang.html
+func DOT(
+	graph *Graph,
+) string {
+	builder := strings.Builder{}
+	builder.WriteString("digraph {\n")
+	builder.WriteString("  node [shape=box];\n")
+
+	for _, node := range graph.Nodes {
+		attrs := fmt.Sprintf("label=%v", dotQuote(strings.Join(node.Label, "\n")))
+		if fillColor, ok := fillColorByOutcome[node.Outcome]; ok {
+			attrs += fmt.Sprintf(", style=filled, fillcolor=%v", fillColor)
+		}
+		fmt.Fprintf(&builder, "  %v [%v];\n", dotQuote(node.ID), attrs)
+	}
+
+	for _, edge := range graph.Edges {
+		attrs := []string{}
+		if edge.Label != "" {
+			attrs = append(attrs, fmt.Sprintf("label=%v", dotQuote(edge.Label)))
+		}
+		if edge.IsNeeds {
+			attrs = append(attrs, "style=dashed")
+		}
+
+		if len(attrs) == 0 {
+			fmt.Fprintf(&builder, "  %v -> %v;\n", dotQuote(edge.From), dotQuote(edge.To))
+		} else {
+			fmt.Fprintf(&builder, "  %v -> %v [%v];\n", dotQuote(edge.From), dotQuote(edge.To), strings.Join(attrs, ", "))
+		}
+	}
+
+	builder.WriteString("}\n")
+	return builder.String()
+}
+
+// dotQuote quotes a DOT string; newlines become line breaks
+func dotQuote(
+	str string,
+) string {
+	return `"` + strings.NewReplacer(`\`, `\\`, `"`, `\"`, "\n", `\n`).Replace(str) + `"`
+}
@@ -0,0 +1,36 @@
+package graph
+
+import (
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/opctl/opctl/sdks/go/model"
+)
+
+var _ = Context("DOT", func() {
+	It("should return expected DOT", func() {
+		/* arrange */
+		graph := &Graph{
+			Nodes: []*Node{
+				{ID: "n1", Label: []string{"container", `name: "quoted"`}, Outcome: model.OpOutcomeSucceeded},
+				{ID: "n2", Label: []string{"op"}},
+			},
+			Edges: []*Edge{
+				{From: "n1", To: "n2", Label: "needs", IsNeeds: true},
+				{From: "n2", To: "n1"},
+			},
+		}
+
+		/* act */
+		actualDOT := DOT(graph)
+
+		/* assert */
+		Expect(actualDOT).To(Equal(`digraph {
+  node [shape=box];
+  "n1" [label="container\nname: \"quoted\"", style=filled, fillcolor=palegreen];
+  "n2" [label="op"];
+  "n1" -> "n2" [label="needs", style=dashed];
+  "n2" -> "n1";
+}
+`))
+	})
+})
@@ -0,0 +1,54 @@
+package graph
+
+// Graph is a call graph; see https://en.wikipedia.org/wiki/Call_graph
+type Graph struct {
+	Nodes []*Node
+	Edges []*Edge
+}
+
+// Node is a call of a graph
+type Node struct {
+	ID string
+	// Label is the lines describing the call
+	Label []string
+	// Outcome is the outcome of the call; empty unless the graph is of a run & the call ended
+	Outcome string
+}
+
+// Edge is a directed edge between calls of a graph
+type Edge struct {
+	From  string
+	To    string
+	Label string
+	// IsNeeds is true if the edge is from a call to a sibling which needs it
+	IsNeeds bool
+}
+
+func (g *Graph) addNode(
+	id string,
+	label ...string,
+) *Node {
+	node := &Node{
+		ID:    id,
+		Label: label,
+	}
+	g.Nodes = append(g.Nodes, node)
+	return node
+}
+
+func (g *Graph) addEdge(
+	from string,
+	to string,
+	label string,
+	isNeeds bool,
+) {
+	g.Edges = append(
+		g.Edges,
+		&Edge{
+			From:    from,
+			To:      to,
+			Label:   label,
+			IsNeeds: isNeeds,
+		},
+	)
+}
@@ -0,0 +1,78 @@
+package graph
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/opctl/opctl/sdks/go/model"
+)
+
+// classByOutcome is the class (styling) of calls by their outcome
+var classByOutcome = map[string]string{
+	model.OpOutcomeFailed:    "failed",
+	model.OpOutcomeKilled:    "killed",
+	model.OpOutcomeSucceeded: "succeeded",
+}
+
+// Mermaid renders a graph as a Mermaid flowchart; see https://mermaid.js.org/syntax/flowchart.html
+func Mermaid(
+	graph *Graph,
+) string {
+	// call ids of runs aren't valid mermaid ids; map them to ones which are
+	idByNodeID := map[string]string{}
+	id := func(nodeID string) string {
+		if _, ok := idByNodeID[nodeID]; !ok {
+			idByNodeID[nodeID] = fmt.Sprintf("c%v", len(idByNodeID)+1)
+		}
+		return idByNodeID[nodeID]
+	}
+
+	builder := strings.Builder{}
+	builder.WriteString("flowchart TD\n")
+
+	classes := map[string]bool{}
+	for _, node := range graph.Nodes {
+		fmt.Fprintf(&builder, "  %v[\"%v\"]\n", id(node.ID), mermaidEscape(strings.Join(node.Label, "\n")))
+		if class, ok := classByOutcome[node.Outcome]; ok {
+			fmt.Fprintf(&builder, "  class %v %v\n", id(node.ID), class)
+			classes[class] = true
+		}
+	}
+
+	for _, edge := range graph.Edges {
+		arrow := "-->"
+		if edge.IsNeeds {
+			arrow = "-.->"
+		}
+
+		if edge.Label == "" {
+			fmt.Fprintf(&builder, "  %v %v %v\n", id(edge.From), arrow, id(edge.To))
+		} else {
+			fmt.Fprintf(&builder, "  %v %v|\"%v\"| %v\n", id(edge.From), arrow, mermaidEscape(edge.Label), id(edge.To))
+		}
+	}
+
+	if classes["succeeded"] {
+		builder.WriteString("  classDef succeeded fill:#98fb98\n")
+	}
+	if classes["failed"] {
+		builder.WriteString("  classDef failed fill:#ffb6c1\n")
+	}
+	if classes["killed"] {
+		builder.WriteString("  classDef killed fill:#d3d3d3\n")
+	}
+
+	return builder.String()
+}
+
+// mermaidEscape escapes text of a mermaid label; newlines become line breaks
+func mermaidEscape(
+	str string,
+) string {
+	return strings.NewReplacer(
+		`"`, "#quot;",
+		"<", "#lt;",
+		">", "#gt;",
+		"\n", "<br/>",
+	).Replace(str)
+}
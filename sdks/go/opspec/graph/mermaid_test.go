@@ -0,0 +1,36 @@
+package graph
+
+import (
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/opctl/opctl/sdks/go/model"
+)
+
+var _ = Context("Mermaid", func() {
+	It("should return expected Mermaid", func() {
+		/* arrange */
+		graph := &Graph{
+			Nodes: []*Node{
+				{ID: "abc-123", Label: []string{"container", `name: "quoted"`}, Outcome: model.OpOutcomeFailed},
+				{ID: "def-456", Label: []string{"op"}},
+			},
+			Edges: []*Edge{
+				{From: "abc-123", To: "def-456", Label: "needs", IsNeeds: true},
+				{From: "def-456", To: "abc-123"},
+			},
+		}
+
+		/* act */
+		actualMermaid := Mermaid(graph)
+
+		/* assert */
+		Expect(actualMermaid).To(Equal(`flowchart TD
+  c1["container<br/>name: #quot;quoted#quot;"]
+  class c1 failed
+  c2["op"]
+  c1 -.->|"needs"| c2
+  c2 --> c1
+  classDef failed fill:#ffb6c1
+`))
+	})
+})
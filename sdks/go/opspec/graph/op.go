@@ -0,0 +1,315 @@
+package graph
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"path/filepath"
+	"regexp"
+	"strings"
+
+	"github.com/opctl/opctl/sdks/go/data"
+	"github.com/opctl/opctl/sdks/go/data/fs"
+	"github.com/opctl/opctl/sdks/go/model"
+	"github.com/opctl/opctl/sdks/go/opspec/opfile"
+)
+
+// FromOp builds the call graph of the op at opPath from its op file.
+// If isRecursive, child ops are resolved from the filesystem (relative to their parent) & then providers
+// & their calls are graphed too; an op called many times is graphed once.
+func FromOp(
+	ctx context.Context,
+	opPath string,
+	isRecursive bool,
+	providers ...model.DataProvider,
+) (*Graph, error) {
+	b := &opBuilder{
+		ctx:            ctx,
+		graph:          &Graph{},
+		isRecursive:    isRecursive,
+		nodeIDByOpPath: map[string]string{},
+		providers:      providers,
+	}
+
+	if _, err := b.addOp(opPath); err != nil {
+		return nil, err
+	}
+
+	return b.graph, nil
+}
+
+type opBuilder struct {
+	ctx         context.Context
+	graph       *Graph
+	isRecursive bool
+	lastID      int
+	// nodeIDByOpPath is the node of each op already graphed
+	nodeIDByOpPath map[string]string
+	providers      []model.DataProvider
+}
+
+func (b *opBuilder) nextID() string {
+	b.lastID++
+	return fmt.Sprintf("n%v", b.lastID)
+}
+
+func (b *opBuilder) addOp(
+	opPath string,
+) (string, error) {
+	if id, ok := b.nodeIDByOpPath[opPath]; ok {
+		return id, nil
+	}
+
+	opFile, err := opfile.Get(b.ctx, opPath)
+	if err != nil {
+		return "", err
+	}
+
+	id := b.nextID()
+	b.nodeIDByOpPath[opPath] = id
+	b.graph.addNode(id, "op", fmt.Sprintf("name: %v", opFile.Name))
+
+	if opFile.Run != nil {
+		runID, err := b.addCall(opPath, opFile.Run)
+		if err != nil {
+			return "", err
+		}
+		b.graph.addEdge(id, runID, "", false)
+	}
+
+	if opFile.Finally != nil {
+		finallyID, err := b.addCall(opPath, opFile.Finally)
+		if err != nil {
+			return "", err
+		}
+		b.graph.addEdge(id, finallyID, "finally", false)
+	}
+
+	return id, nil
+}
+
+func (b *opBuilder) addCall(
+	opPath string,
+	callSpec *model.CallSpec,
+) (string, error) {
+	id := b.nextID()
+	node := b.graph.addNode(id)
+
+	if callSpec.Name != nil {
+		node.Label = append(node.Label, fmt.Sprintf("name: %v", *callSpec.Name))
+	}
+
+	var err error
+	switch {
+	case callSpec.Container != nil:
+		node.Label = append([]string{"container"}, node.Label...)
+		if callSpec.Container.Image != nil {
+			node.Label = append(node.Label, fmt.Sprintf("image: %v", callSpec.Container.Image.Ref))
+		}
+	case callSpec.Dag != nil:
+		node.Label = append([]string{"dag"}, node.Label...)
+		err = b.addChildren(opPath, id, *callSpec.Dag, false)
+	case callSpec.Op != nil:
+		node.Label = append([]string{"op"}, node.Label...)
+		node.Label = append(node.Label, fmt.Sprintf("ref: %v", callSpec.Op.Ref))
+		err = b.addChildOp(opPath, id, callSpec.Op.Ref)
+	case callSpec.Parallel != nil:
+		node.Label = append([]string{"parallel"}, node.Label...)
+		err = b.addChildren(opPath, id, *callSpec.Parallel, false)
+	case callSpec.ParallelLoop != nil:
+		node.Label = append([]string{"parallelLoop"}, node.Label...)
+		node.Label = append(node.Label, loopLabel(callSpec.ParallelLoop.Range, callSpec.ParallelLoop.Matrix)...)
+		err = b.addChild(opPath, id, &callSpec.ParallelLoop.Run, "each")
+	case callSpec.Serial != nil:
+		node.Label = append([]string{"serial"}, node.Label...)
+		err = b.addChildren(opPath, id, *callSpec.Serial, true)
+	case callSpec.SerialLoop != nil:
+		node.Label = append([]string{"serialLoop"}, node.Label...)
+		node.Label = append(node.Label, loopLabel(callSpec.SerialLoop.Range, callSpec.SerialLoop.Matrix)...)
+		if len(callSpec.SerialLoop.Until) > 0 {
+			node.Label = append(node.Label, fmt.Sprintf("until: %v", formatPredicates(callSpec.SerialLoop.Until)))
+		}
+		err = b.addChild(opPath, id, &callSpec.SerialLoop.Run, "each")
+	case callSpec.Switch != nil:
+		node.Label = append([]string{"switch"}, node.Label...)
+		for _, switchCase := range callSpec.Switch.Cases {
+			if err = b.addChild(opPath, id, &switchCase.Run, formatPredicates(switchCase.If)); err != nil {
+				break
+			}
+		}
+		if err == nil && callSpec.Switch.Default != nil {
+			err = b.addChild(opPath, id, callSpec.Switch.Default, "default")
+		}
+	}
+	if err != nil {
+		return "", err
+	}
+
+	if callSpec.If != nil {
+		node.Label = append(node.Label, fmt.Sprintf("if: %v", formatPredicates(*callSpec.If)))
+	}
+
+	if callSpec.Else != nil {
+		if err := b.addChild(opPath, id, callSpec.Else, "else"); err != nil {
+			return "", err
+		}
+	}
+
+	if callSpec.Finally != nil {
+		if err := b.addChild(opPath, id, callSpec.Finally, "finally"); err != nil {
+			return "", err
+		}
+	}
+
+	return id, nil
+}
+
+func (b *opBuilder) addChild(
+	opPath string,
+	parentID string,
+	callSpec *model.CallSpec,
+	label string,
+) error {
+	childID, err := b.addCall(opPath, callSpec)
+	if err != nil {
+		return err
+	}
+	b.graph.addEdge(parentID, childID, label, false)
+	return nil
+}
+
+// addChildren adds the children of a serial, parallel, or dag call;
+// children of serial calls are labeled w/ their order & needs between siblings are added as edges
+func (b *opBuilder) addChildren(
+	opPath string,
+	parentID string,
+	callSpecs []*model.CallSpec,
+	isSerial bool,
+) error {
+	idByName := map[string]string{}
+	ids := make([]string, len(callSpecs))
+	for i, callSpec := range callSpecs {
+		label := ""
+		if isSerial {
+			label = fmt.Sprintf("%v", i+1)
+		}
+
+		childID, err := b.addCall(opPath, callSpec)
+		if err != nil {
+			return err
+		}
+		b.graph.addEdge(parentID, childID, label, false)
+
+		ids[i] = childID
+		if callSpec.Name != nil {
+			idByName[*callSpec.Name] = childID
+		}
+	}
+
+	for i, callSpec := range callSpecs {
+		for _, neededRef := range callSpec.Needs {
+			neededName := strings.TrimSuffix(strings.TrimPrefix(neededRef, "$("), ")")
+			if neededID, ok := idByName[neededName]; ok {
+				b.graph.addEdge(neededID, ids[i], "needs", true)
+			}
+		}
+	}
+
+	return nil
+}
+
+// addChildOp adds the op referenced by opRef as a child of the op call w/ parentID if graphing recursively;
+// refs determined at runtime can't be followed
+func (b *opBuilder) addChildOp(
+	opPath string,
+	parentID string,
+	opRef string,
+) error {
+	if !b.isRecursive || regexp.MustCompile("^\\$\\(.+\\)$").MatchString(opRef) {
+		return nil
+	}
+
+	opHandle, err := data.Resolve(
+		b.ctx,
+		opRef,
+		append(
+			[]model.DataProvider{fs.New(opPath, filepath.Dir(opPath))},
+			b.providers...,
+		)...,
+	)
+	if err != nil {
+		return err
+	}
+
+	childOpPath := opHandle.Path()
+	if childOpPath == nil {
+		return fmt.Errorf("unable to graph op '%v': op not available locally", opRef)
+	}
+
+	childID, err := b.addOp(*childOpPath)
+	if err != nil {
+		return err
+	}
+	b.graph.addEdge(parentID, childID, "", false)
+
+	return nil
+}
+
+func loopLabel(
+	loopRange interface{},
+	matrix interface{},
+) []string {
+	label := []string{}
+	if loopRange != nil {
+		label = append(label, fmt.Sprintf("range: %v", format(loopRange)))
+	}
+	if matrix != nil {
+		label = append(label, fmt.Sprintf("matrix: %v", format(matrix)))
+	}
+	return label
+}
+
+// formatPredicates formats predicates in the form they're written i.e. eq($(a), b) && exists($(c))
+func formatPredicates(
+	predicateSpecs []*model.PredicateSpec,
+) string {
+	formatted := []string{}
+	for _, predicateSpec := range predicateSpecs {
+		switch {
+		case predicateSpec.Eq != nil:
+			formatted = append(formatted, fmt.Sprintf("eq(%v)", formatAll(*predicateSpec.Eq)))
+		case predicateSpec.Exists != nil:
+			formatted = append(formatted, fmt.Sprintf("exists(%v)", *predicateSpec.Exists))
+		case predicateSpec.Ne != nil:
+			formatted = append(formatted, fmt.Sprintf("ne(%v)", formatAll(*predicateSpec.Ne)))
+		case predicateSpec.NotExists != nil:
+			formatted = append(formatted, fmt.Sprintf("notExists(%v)", *predicateSpec.NotExists))
+		}
+	}
+	return strings.Join(formatted, " && ")
+}
+
+func formatAll(
+	values []interface{},
+) string {
+	formatted := make([]string, len(values))
+	for i, value := range values {
+		formatted[i] = format(value)
+	}
+	return strings.Join(formatted, ", ")
+}
+
+// format formats a value of an op file; strings are formatted as is, other values as JSON
+func format(
+	value interface{},
+) string {
+	if str, ok := value.(string); ok {
+		return str
+	}
+
+	valueBytes, err := json.Marshal(value)
+	if err != nil {
+		return fmt.Sprintf("%v", value)
+	}
+	return string(valueBytes)
+}
@@ -0,0 +1,92 @@
+package graph
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Context("FromOp", func() {
+	wd, err := os.Getwd()
+	if err != nil {
+		panic(err)
+	}
+
+	Context("not recursive", func() {
+		It("should return expected graph", func() {
+			/* arrange */
+			opPath := filepath.Join(wd, "testdata/parent")
+
+			/* act */
+			actualGraph, actualErr := FromOp(
+				context.Background(),
+				opPath,
+				false,
+			)
+
+			/* assert */
+			Expect(actualErr).To(BeNil())
+			Expect(*actualGraph).To(Equal(Graph{
+				Nodes: []*Node{
+					{ID: "n1", Label: []string{"op", "name: parent"}},
+					{ID: "n2", Label: []string{"serial"}},
+					{ID: "n3", Label: []string{"container", "name: greet", "image: alpine:3.12"}},
+					{ID: "n4", Label: []string{"parallel"}},
+					{ID: "n5", Label: []string{"op", "name: build", "ref: ../child"}},
+					{ID: "n6", Label: []string{"container", "name: test", "image: golang:1.15", "if: exists($(ci))"}},
+				},
+				Edges: []*Edge{
+					{From: "n2", To: "n3", Label: "1"},
+					{From: "n4", To: "n5"},
+					{From: "n4", To: "n6"},
+					{From: "n5", To: "n6", Label: "needs", IsNeeds: true},
+					{From: "n2", To: "n4", Label: "2"},
+					{From: "n1", To: "n2"},
+				},
+			}))
+		})
+	})
+	Context("recursive", func() {
+		It("should graph child ops", func() {
+			/* arrange */
+			opPath := filepath.Join(wd, "testdata/parent")
+
+			/* act */
+			actualGraph, actualErr := FromOp(
+				context.Background(),
+				opPath,
+				true,
+			)
+
+			/* assert */
+			Expect(actualErr).To(BeNil())
+			Expect(actualGraph.Nodes).To(ContainElements(
+				&Node{ID: "n6", Label: []string{"op", "name: child"}},
+				&Node{ID: "n7", Label: []string{"container", "image: golang:1.15"}},
+			))
+			Expect(actualGraph.Edges).To(ContainElements(
+				&Edge{From: "n5", To: "n6"},
+				&Edge{From: "n6", To: "n7"},
+			))
+		})
+	})
+	Context("child op doesn't exist", func() {
+		It("should return expected error", func() {
+			/* arrange */
+			opPath := filepath.Join(wd, "testdata/missingchild")
+
+			/* act */
+			_, actualErr := FromOp(
+				context.Background(),
+				opPath,
+				true,
+			)
+
+			/* assert */
+			Expect(actualErr).To(MatchError(ContainSubstring("../doesnotexist")))
+		})
+	})
+})
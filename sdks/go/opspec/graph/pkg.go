@@ -0,0 +1,2 @@
+// Package graph exposes functionality for building call graphs of ops & runs and rendering them as DOT or Mermaid.
+package graph
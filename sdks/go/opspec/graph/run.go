@@ -0,0 +1,109 @@
+package graph
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/opctl/opctl/sdks/go/model"
+)
+
+// FromRun builds the call graph of the run w/ rootCallID from its events;
+// calls are labeled w/ their outcome & duration. Events of other runs are ignored.
+func FromRun(
+	rootCallID string,
+	events []model.Event,
+) *Graph {
+	graph := &Graph{}
+	nodeByID := map[string]*Node{}
+	startedByID := map[string]time.Time{}
+
+	addCall := func(call model.Call) *Node {
+		if node, ok := nodeByID[call.ID]; ok {
+			return node
+		}
+
+		node := graph.addNode(call.ID, callLabel(call)...)
+		nodeByID[call.ID] = node
+		if call.ParentID != nil {
+			graph.addEdge(*call.ParentID, call.ID, "", false)
+		}
+		return node
+	}
+
+	for _, event := range events {
+		switch {
+		case event.CallStarted != nil && event.CallStarted.Call.RootID == rootCallID:
+			addCall(event.CallStarted.Call)
+			startedByID[event.CallStarted.Call.ID] = event.Timestamp
+		case event.CallEnded != nil && event.CallEnded.Call.RootID == rootCallID:
+			node := addCall(event.CallEnded.Call)
+			node.Outcome = event.CallEnded.Outcome
+
+			ended := node.Outcome
+			if started, ok := startedByID[event.CallEnded.Call.ID]; ok {
+				ended = fmt.Sprintf("%v in %v", ended, event.Timestamp.Sub(started).Round(time.Millisecond))
+			}
+			node.Label = append(node.Label, ended)
+
+			if event.CallEnded.Error != nil {
+				node.Label = append(node.Label, fmt.Sprintf("error: %v", event.CallEnded.Error.Message))
+			}
+		}
+	}
+
+	for _, node := range graph.Nodes {
+		if node.Outcome == "" {
+			node.Label = append(node.Label, "running")
+		}
+	}
+
+	return graph
+}
+
+func callLabel(
+	call model.Call,
+) []string {
+	label := []string{}
+	switch {
+	case call.Container != nil:
+		label = append(label, "container")
+	case call.Dag != nil:
+		label = append(label, "dag")
+	case call.Op != nil:
+		label = append(label, "op")
+	case call.Parallel != nil:
+		label = append(label, "parallel")
+	case call.ParallelLoop != nil:
+		label = append(label, "parallelLoop")
+	case call.Serial != nil:
+		label = append(label, "serial")
+	case call.SerialLoop != nil:
+		label = append(label, "serialLoop")
+	case call.Switch != nil:
+		label = append(label, "switch")
+	default:
+		label = append(label, "call")
+	}
+
+	if call.Name != nil {
+		label = append(label, fmt.Sprintf("name: %v", *call.Name))
+	}
+
+	if call.Container != nil && call.Container.Image != nil && call.Container.Image.Ref != nil {
+		label = append(label, fmt.Sprintf("image: %v", *call.Container.Image.Ref))
+	}
+
+	if call.Op != nil && call.Op.Ref != "" {
+		label = append(label, fmt.Sprintf("ref: %v", call.Op.Ref))
+	}
+
+	if call.If != nil && !*call.If {
+		label = append(label, "if: false")
+	}
+
+	if call.IsFinally {
+		label = append(label, "finally")
+	}
+
+	return label
+}
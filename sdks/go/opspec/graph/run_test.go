@@ -0,0 +1,85 @@
+package graph
+
+import (
+	"time"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/opctl/opctl/sdks/go/model"
+)
+
+var _ = Context("FromRun", func() {
+	It("should return expected graph", func() {
+		/* arrange */
+		rootCallID := "root"
+		imageRef := "alpine:3.12"
+		name := "greet"
+		started := time.Now()
+
+		events := []model.Event{
+			{
+				CallStarted: &model.CallStarted{
+					Call: model.Call{
+						ID:     rootCallID,
+						Op:     &model.OpCall{Ref: "github.com/org/ops#1.0.0"},
+						RootID: rootCallID,
+					},
+				},
+				Timestamp: started,
+			},
+			{
+				CallStarted: &model.CallStarted{
+					Call: model.Call{
+						ID:     "other",
+						RootID: "other",
+					},
+				},
+				Timestamp: started,
+			},
+			{
+				CallStarted: &model.CallStarted{
+					Call: model.Call{
+						Container: &model.ContainerCall{
+							Image: &model.ContainerCallImage{Ref: &imageRef},
+						},
+						ID:       "container",
+						Name:     &name,
+						ParentID: &rootCallID,
+						RootID:   rootCallID,
+					},
+				},
+				Timestamp: started.Add(time.Second),
+			},
+			{
+				CallEnded: &model.CallEnded{
+					Call: model.Call{
+						ID:       "container",
+						ParentID: &rootCallID,
+						RootID:   rootCallID,
+					},
+					Error:   &model.CallEndedError{Message: "exit code 1"},
+					Outcome: model.OpOutcomeFailed,
+				},
+				Timestamp: started.Add(3500 * time.Millisecond),
+			},
+		}
+
+		/* act */
+		actualGraph := FromRun(rootCallID, events)
+
+		/* assert */
+		Expect(*actualGraph).To(Equal(Graph{
+			Nodes: []*Node{
+				{ID: rootCallID, Label: []string{"op", "ref: github.com/org/ops#1.0.0", "running"}},
+				{
+					ID:      "container",
+					Label:   []string{"container", "name: greet", "image: alpine:3.12", "FAILED in 2.5s", "error: exit code 1"},
+					Outcome: model.OpOutcomeFailed,
+				},
+			},
+			Edges: []*Edge{
+				{From: rootCallID, To: "container"},
+			},
+		}))
+	})
+})
@@ -0,0 +1,18 @@
+// Package varref converts between variable names & references to them; shared by opspec & its validator,
+// which opspec depends on so can't depend on opspec.
+package varref
+
+import (
+	"fmt"
+	"strings"
+)
+
+// ToName converts a variable reference to the name of the variable
+func ToName(ref string) string {
+	return strings.TrimSuffix(strings.TrimPrefix(ref, "$("), ")")
+}
+
+// FromName converts a variable name to the reference form in an opspec
+func FromName(name string) string {
+	return fmt.Sprintf("$(%s)", name)
+}
@@ -0,0 +1,113 @@
+package dag
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/opctl/opctl/sdks/go/model"
+)
+
+// Needs interprets the needs of each call of a dag to the indices of the calls it needs.
+// An error is returned if a need isn't the name of another call of the dag or needs form a cycle.
+func Needs(
+	callSpecs []*model.CallSpec,
+) ([][]int, error) {
+	indexByName := map[string]int{}
+	for index, callSpec := range callSpecs {
+		if callSpec.Name != nil {
+			indexByName[*callSpec.Name] = index
+		}
+	}
+
+	neededIndicesByIndex := make([][]int, len(callSpecs))
+	for index, callSpec := range callSpecs {
+		for _, neededRef := range callSpec.Needs {
+			neededName := strings.TrimSuffix(strings.TrimPrefix(neededRef, "$("), ")")
+			neededIndex, ok := indexByName[neededName]
+			if !ok || neededIndex == index {
+				return nil, fmt.Errorf("unable to interpret need '%v': not the name of a sibling call", neededName)
+			}
+			neededIndicesByIndex[index] = append(neededIndicesByIndex[index], neededIndex)
+		}
+	}
+
+	// detect cycles via depth first search
+	const (
+		unvisited = iota
+		visiting
+		visited
+	)
+	stateByIndex := make([]int, len(callSpecs))
+	var visit func(index int, path []int) error
+	visit = func(index int, path []int) error {
+		switch stateByIndex[index] {
+		case visiting:
+			cycleNames := []string{}
+			for i := len(path) - 1; i >= 0; i-- {
+				cycleNames = append([]string{name(callSpecs[path[i]], path[i])}, cycleNames...)
+				if path[i] == index {
+					break
+				}
+			}
+			cycleNames = append(cycleNames, name(callSpecs[index], index))
+			return fmt.Errorf("unable to interpret needs: needs form a cycle '%v'", strings.Join(cycleNames, " -> "))
+		case visited:
+			return nil
+		}
+
+		stateByIndex[index] = visiting
+		for _, neededIndex := range neededIndicesByIndex[index] {
+			if err := visit(neededIndex, append(path, index)); err != nil {
+				return err
+			}
+		}
+		stateByIndex[index] = visited
+
+		return nil
+	}
+
+	for index := range callSpecs {
+		if err := visit(index, nil); err != nil {
+			return nil, err
+		}
+	}
+
+	return neededIndicesByIndex, nil
+}
+
+// Ancestors returns the indices of all calls needed by the call at index, directly or transitively, in ascending order
+func Ancestors(
+	neededIndicesByIndex [][]int,
+	index int,
+) []int {
+	isAncestor := map[int]bool{}
+	var visit func(index int)
+	visit = func(index int) {
+		for _, neededIndex := range neededIndicesByIndex[index] {
+			if !isAncestor[neededIndex] {
+				isAncestor[neededIndex] = true
+				visit(neededIndex)
+			}
+		}
+	}
+	visit(index)
+
+	ancestors := []int{}
+	for ancestor := range isAncestor {
+		ancestors = append(ancestors, ancestor)
+	}
+	sort.Ints(ancestors)
+
+	return ancestors
+}
+
+func name(
+	callSpec *model.CallSpec,
+	index int,
+) string {
+	if callSpec.Name != nil {
+		return *callSpec.Name
+	}
+	return fmt.Sprintf("[%v]", index)
+}
@@ -0,0 +1,83 @@
+package dag
+
+import (
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/opctl/opctl/sdks/go/model"
+)
+
+var _ = Context("Needs", func() {
+	callSpec := func(name string, needs ...string) *model.CallSpec {
+		return &model.CallSpec{
+			Name:  &name,
+			Needs: needs,
+		}
+	}
+
+	Context("need not a sibling", func() {
+		It("should return expected error", func() {
+			/* arrange */
+			/* act */
+			_, actualErr := Needs([]*model.CallSpec{
+				callSpec("a", "b"),
+			})
+
+			/* assert */
+			Expect(actualErr).To(MatchError("unable to interpret need 'b': not the name of a sibling call"))
+		})
+	})
+	Context("need is self", func() {
+		It("should return expected error", func() {
+			/* arrange */
+			/* act */
+			_, actualErr := Needs([]*model.CallSpec{
+				callSpec("a", "$(a)"),
+			})
+
+			/* assert */
+			Expect(actualErr).To(MatchError("unable to interpret need 'a': not the name of a sibling call"))
+		})
+	})
+	Context("needs form a cycle", func() {
+		It("should return expected error", func() {
+			/* arrange */
+			/* act */
+			_, actualErr := Needs([]*model.CallSpec{
+				callSpec("a", "c"),
+				callSpec("b", "a"),
+				callSpec("c", "b"),
+				callSpec("d", "a"),
+			})
+
+			/* assert */
+			Expect(actualErr).To(MatchError("unable to interpret needs: needs form a cycle 'a -> c -> b -> a'"))
+		})
+	})
+	Context("needs acyclic", func() {
+		It("should return expected result", func() {
+			/* arrange */
+			/* act */
+			actualNeeds, actualErr := Needs([]*model.CallSpec{
+				callSpec("a"),
+				callSpec("b", "a"),
+				callSpec("c", "$(a)", "b"),
+				{},
+			})
+
+			/* assert */
+			Expect(actualErr).To(BeNil())
+			Expect(actualNeeds).To(Equal([][]int{nil, {0}, {0, 1}, nil}))
+		})
+	})
+})
+
+var _ = Context("Ancestors", func() {
+	It("should return direct & transitive needs in ascending order", func() {
+		/* arrange */
+		/* act */
+		actualAncestors := Ancestors([][]int{nil, {0}, nil, {1, 2}, {3}}, 4)
+
+		/* assert */
+		Expect(actualAncestors).To(Equal([]int{0, 1, 2, 3}))
+	})
+})
@@ -0,0 +1,2 @@
+// Package dag exposes functionality for interpreting dag calls; i.e. calls whose children start once the calls they need succeed.
+package dag
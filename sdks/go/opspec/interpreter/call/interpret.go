@@ -10,6 +10,7 @@ import (
 	"github.com/opctl/opctl/sdks/go/opspec/interpreter/call/parallelloop"
 	"github.com/opctl/opctl/sdks/go/opspec/interpreter/call/predicates"
 	"github.com/opctl/opctl/sdks/go/opspec/interpreter/call/serialloop"
+	"github.com/opctl/opctl/sdks/go/opspec/interpreter/call/switchcall"
 )
 
 //Interpret a spec into a call
@@ -44,7 +45,8 @@ func Interpret(
 		call.If = &callIf
 
 		if !callIf {
-			// end interpretation early since call will be skipped
+			// end interpretation early since call will be skipped (or its else called)
+			call.Else = callSpec.Else
 			return call, err
 		}
 	}
@@ -59,6 +61,9 @@ func Interpret(
 			dataDirPath,
 		)
 		return call, err
+	case callSpec.Dag != nil:
+		call.Dag = *callSpec.Dag
+		return call, nil
 	case callSpec.Op != nil:
 		call.Op, err = op.Interpret(
 			ctx,
@@ -87,6 +92,12 @@ func Interpret(
 			scope,
 		)
 		return call, err
+	case callSpec.Switch != nil:
+		call.Switch, err = switchcall.Interpret(
+			*callSpec.Switch,
+			scope,
+		)
+		return call, err
 	default:
 		return nil, fmt.Errorf("invalid call graph '%+v'", callSpec)
 	}
@@ -42,6 +42,47 @@ var _ = Context("Interpret", func() {
 				Expect(actualError).To(MatchError("unable to interpret predicate: predicate was unexpected type &{Eq:<nil> Exists:<nil> Ne:<nil> NotExists:<nil>}"))
 			})
 		})
+		Context("predicates false & callSpec.Else not nil", func() {
+			It("should return expected result", func() {
+				/* arrange */
+				isFalse := []interface{}{true, false}
+				predicateSpec := []*model.PredicateSpec{{Eq: &isFalse}}
+				providedElse := &model.CallSpec{
+					Serial: &[]*model.CallSpec{},
+				}
+				dataDir, err := ioutil.TempDir("", "")
+				if err != nil {
+					panic(err)
+				}
+
+				expectedIf := false
+
+				/* act */
+				actualCall, actualError := Interpret(
+					context.Background(),
+					map[string]*model.Value{},
+					&model.CallSpec{
+						Else:      providedElse,
+						If:        &predicateSpec,
+						Container: &model.ContainerCallSpec{},
+					},
+					"providedID",
+					"dummyOpPath",
+					nil,
+					"providedRootCallID",
+					dataDir,
+				)
+
+				/* assert */
+				Expect(actualError).To(BeNil())
+				Expect(*actualCall).To(Equal(model.Call{
+					Else:   providedElse,
+					ID:     "providedID",
+					If:     &expectedIf,
+					RootID: "providedRootCallID",
+				}))
+			})
+		})
 	})
 	Context("callSpec.Container not nil", func() {
 		It("should return expected result", func() {
@@ -251,4 +292,43 @@ var _ = Context("Interpret", func() {
 
 		})
 	})
+	Context("callSpec.Switch not nil", func() {
+		It("should return expected result", func() {
+			/* arrange */
+			providedDataDirPath, err := ioutil.TempDir("", "")
+			if err != nil {
+				panic(err)
+			}
+
+			providedDefault := &model.CallSpec{
+				Serial: &[]*model.CallSpec{},
+			}
+
+			/* act */
+			actualCall, actualError := Interpret(
+				context.Background(),
+				map[string]*model.Value{},
+				&model.CallSpec{
+					Switch: &model.SwitchCallSpec{
+						Default: providedDefault,
+					},
+				},
+				"providedID",
+				"providedOpPath",
+				nil,
+				"providedRootCallID",
+				providedDataDirPath,
+			)
+
+			/* assert */
+			Expect(actualError).To(BeNil())
+			Expect(*actualCall).To(Equal(model.Call{
+				ID:     "providedID",
+				RootID: "providedRootCallID",
+				Switch: &model.SwitchCall{
+					Run: providedDefault,
+				},
+			}))
+		})
+	})
 })
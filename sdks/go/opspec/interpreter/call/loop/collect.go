@@ -0,0 +1,84 @@
+package loop
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/opctl/opctl/sdks/go/model"
+	"github.com/opctl/opctl/sdks/go/opspec"
+	"github.com/opctl/opctl/sdks/go/opspec/interpreter/loopable"
+	"github.com/pkg/errors"
+)
+
+// Collect adds each output named in collectSpec to scope, gathered from the outputs of every iteration;
+// if the loop ranges over an object, outputs are collected into an object keyed by loop key,
+// otherwise into an array in iteration order (w/ null for iterations which didn't output it).
+func Collect(
+	scope map[string]*model.Value,
+	collectSpec []string,
+	callSpecLoopRange interface{},
+	iterationOutputs []map[string]*model.Value,
+) (
+	map[string]*model.Value,
+	error,
+) {
+	if len(collectSpec) == 0 {
+		return scope, nil
+	}
+
+	var sortedKeys []string
+	if callSpecLoopRange != nil {
+		loopRange, err := loopable.Interpret(
+			callSpecLoopRange,
+			scope,
+		)
+		if err != nil {
+			return nil, err
+		}
+
+		if loopRange.Object != nil {
+			sortedKeys = []string{}
+			for key := range *loopRange.Object {
+				sortedKeys = append(sortedKeys, key)
+			}
+			// iterations range over keys alphabetically
+			sort.Strings(sortedKeys)
+		}
+	}
+
+	outboundScope := map[string]*model.Value{}
+	for varName, varData := range scope {
+		outboundScope[varName] = varData
+	}
+
+	for _, outputRef := range collectSpec {
+		outputName := opspec.RefToName(outputRef)
+
+		collectedArray := []interface{}{}
+		collectedObject := map[string]interface{}{}
+		for i, outputs := range iterationOutputs {
+			var item interface{}
+			if output, ok := outputs[outputName]; ok && output != nil {
+				var err error
+				item, err = output.Unbox()
+				if err != nil {
+					return nil, errors.Wrap(err, fmt.Sprintf("unable to collect '%v' from iteration %v", outputName, i))
+				}
+			}
+
+			if sortedKeys == nil {
+				collectedArray = append(collectedArray, item)
+			} else if item != nil && i < len(sortedKeys) {
+				collectedObject[sortedKeys[i]] = item
+			}
+		}
+
+		if sortedKeys == nil {
+			outboundScope[outputName] = &model.Value{Array: &collectedArray}
+		} else {
+			outboundScope[outputName] = &model.Value{Object: &collectedObject}
+		}
+	}
+
+	return outboundScope, nil
+}
@@ -0,0 +1,79 @@
+package loop
+
+import (
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/opctl/opctl/sdks/go/model"
+)
+
+var _ = Context("Collect", func() {
+	Context("collectSpec empty", func() {
+		It("should return scope", func() {
+			/* arrange */
+			providedScope := map[string]*model.Value{
+				"name": {String: new(string)},
+			}
+
+			/* act */
+			actualScope, actualErr := Collect(
+				providedScope,
+				nil,
+				nil,
+				[]map[string]*model.Value{},
+			)
+
+			/* assert */
+			Expect(actualErr).To(BeNil())
+			Expect(actualScope).To(Equal(providedScope))
+		})
+	})
+	Context("range is array", func() {
+		It("should return expected result", func() {
+			/* arrange */
+			digest0 := "digest0"
+			digest2 := "digest2"
+
+			/* act */
+			actualScope, actualErr := Collect(
+				map[string]*model.Value{},
+				[]string{"$(digest)"},
+				[]interface{}{"a", "b", "c"},
+				[]map[string]*model.Value{
+					{"digest": {String: &digest0}},
+					{},
+					{"digest": {String: &digest2}},
+				},
+			)
+
+			/* assert */
+			Expect(actualErr).To(BeNil())
+			Expect(actualScope).To(Equal(map[string]*model.Value{
+				"digest": {Array: &[]interface{}{digest0, nil, digest2}},
+			}))
+		})
+	})
+	Context("range is object", func() {
+		It("should return expected result", func() {
+			/* arrange */
+			digestA := "digestA"
+			digestB := "digestB"
+
+			/* act */
+			actualScope, actualErr := Collect(
+				map[string]*model.Value{},
+				[]string{"$(digest)"},
+				map[string]interface{}{"b": 1, "a": 2},
+				[]map[string]*model.Value{
+					{"digest": {String: &digestA}},
+					{"digest": {String: &digestB}},
+				},
+			)
+
+			/* assert */
+			Expect(actualErr).To(BeNil())
+			Expect(actualScope).To(Equal(map[string]*model.Value{
+				"digest": {Object: &map[string]interface{}{"a": digestA, "b": digestB}},
+			}))
+		})
+	})
+})
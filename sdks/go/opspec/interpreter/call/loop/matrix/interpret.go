@@ -0,0 +1,135 @@
+package matrix
+
+import (
+	"fmt"
+	"reflect"
+	"sort"
+
+	"github.com/opctl/opctl/sdks/go/model"
+	"github.com/opctl/opctl/sdks/go/opspec/interpreter/object"
+	"github.com/pkg/errors"
+)
+
+const (
+	excludeKey = "exclude"
+	includeKey = "include"
+)
+
+// Interpret a matrix into the array of combinations it ranges over.
+//
+// Combinations are the cartesian product of the matrix's axes (all properties other than include & exclude, each an array),
+// w/ axes ordered alphabetically & the last varying fastest. Combinations matching every property of any exclude entry
+// are removed, then include entries are appended.
+func Interpret(
+	matrixSpec interface{},
+	scope map[string]*model.Value,
+) (*model.Value, error) {
+	matrixValue, err := object.Interpret(
+		scope,
+		matrixSpec,
+	)
+	if err != nil {
+		return nil, errors.Wrap(err, "unable to interpret matrix")
+	}
+	matrix := *matrixValue.Object
+
+	axisNames := []string{}
+	for name := range matrix {
+		if name != excludeKey && name != includeKey {
+			axisNames = append(axisNames, name)
+		}
+	}
+	sort.Strings(axisNames)
+
+	combinations := []map[string]interface{}{}
+	if len(axisNames) > 0 {
+		combinations = append(combinations, map[string]interface{}{})
+	}
+	for _, axisName := range axisNames {
+		axisValues, ok := matrix[axisName].([]interface{})
+		if !ok {
+			return nil, fmt.Errorf("unable to interpret matrix: axis '%v' not an array", axisName)
+		}
+
+		nextCombinations := []map[string]interface{}{}
+		for _, combination := range combinations {
+			for _, axisValue := range axisValues {
+				nextCombination := map[string]interface{}{}
+				for name, value := range combination {
+					nextCombination[name] = value
+				}
+				nextCombination[axisName] = axisValue
+				nextCombinations = append(nextCombinations, nextCombination)
+			}
+		}
+		combinations = nextCombinations
+	}
+
+	excludes, err := entries(matrix, excludeKey)
+	if err != nil {
+		return nil, err
+	}
+
+	includes, err := entries(matrix, includeKey)
+	if err != nil {
+		return nil, err
+	}
+
+	matrixRange := []interface{}{}
+	for _, combination := range combinations {
+		if !isExcluded(combination, excludes) {
+			matrixRange = append(matrixRange, combination)
+		}
+	}
+	for _, include := range includes {
+		matrixRange = append(matrixRange, include)
+	}
+
+	return &model.Value{Array: &matrixRange}, nil
+}
+
+// entries returns the entries of the include or exclude list of matrix
+func entries(
+	matrix map[string]interface{},
+	key string,
+) ([]map[string]interface{}, error) {
+	list, ok := matrix[key]
+	if !ok {
+		return nil, nil
+	}
+
+	items, ok := list.([]interface{})
+	if !ok {
+		return nil, fmt.Errorf("unable to interpret matrix: %v not an array", key)
+	}
+
+	entries := []map[string]interface{}{}
+	for i, item := range items {
+		entry, ok := item.(map[string]interface{})
+		if !ok {
+			return nil, fmt.Errorf("unable to interpret matrix: %v[%v] not an object", key, i)
+		}
+		entries = append(entries, entry)
+	}
+	return entries, nil
+}
+
+// isExcluded tests if combination matches every property of any exclude
+func isExcluded(
+	combination map[string]interface{},
+	excludes []map[string]interface{},
+) bool {
+	for _, exclude := range excludes {
+		isMatch := true
+		for name, value := range exclude {
+			if !reflect.DeepEqual(combination[name], value) {
+				isMatch = false
+				break
+			}
+		}
+		if isMatch {
+			return true
+		}
+	}
+	return false
+}
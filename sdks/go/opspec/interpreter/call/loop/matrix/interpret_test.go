@@ -0,0 +1,66 @@
+package matrix
+
+import (
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/opctl/opctl/sdks/go/model"
+)
+
+var _ = Context("Interpret", func() {
+	Context("matrix not an object", func() {
+		It("should return expected error", func() {
+			/* arrange/act */
+			_, actualErr := Interpret(
+				"$(undefined)",
+				map[string]*model.Value{},
+			)
+
+			/* assert */
+			Expect(actualErr).To(HaveOccurred())
+		})
+	})
+	Context("axis not an array", func() {
+		It("should return expected error", func() {
+			/* arrange/act */
+			_, actualErr := Interpret(
+				map[string]interface{}{
+					"os": "linux",
+				},
+				map[string]*model.Value{},
+			)
+
+			/* assert */
+			Expect(actualErr).To(MatchError("unable to interpret matrix: axis 'os' not an array"))
+		})
+	})
+	It("should return expected result", func() {
+		/* arrange */
+		goVersions := []interface{}{"1.14", "1.15"}
+
+		/* act */
+		actualResult, actualErr := Interpret(
+			map[string]interface{}{
+				"os": []interface{}{"linux", "windows"},
+				"go": "$(goVersions)",
+				"exclude": []interface{}{
+					map[string]interface{}{"go": "1.14", "os": "windows"},
+				},
+				"include": []interface{}{
+					map[string]interface{}{"go": "1.15", "os": "darwin"},
+				},
+			},
+			map[string]*model.Value{
+				"goVersions": {Array: &goVersions},
+			},
+		)
+
+		/* assert */
+		Expect(actualErr).To(BeNil())
+		Expect(*actualResult.Array).To(Equal([]interface{}{
+			map[string]interface{}{"go": "1.14", "os": "linux"},
+			map[string]interface{}{"go": "1.15", "os": "linux"},
+			map[string]interface{}{"go": "1.15", "os": "windows"},
+			map[string]interface{}{"go": "1.15", "os": "darwin"},
+		}))
+	})
+})
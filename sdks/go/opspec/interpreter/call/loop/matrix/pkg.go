@@ -0,0 +1,2 @@
+// Package matrix implements interpretation of loop matrices
+package matrix
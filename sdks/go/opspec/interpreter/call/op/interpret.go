@@ -9,12 +9,16 @@ import (
 	"github.com/opctl/opctl/sdks/go/data"
 	"github.com/opctl/opctl/sdks/go/data/fs"
 	"github.com/opctl/opctl/sdks/go/data/git"
+	"github.com/opctl/opctl/sdks/go/data/oci"
+	"github.com/opctl/opctl/sdks/go/data/tarball"
+	"github.com/opctl/opctl/sdks/go/internal/redact"
 	"github.com/opctl/opctl/sdks/go/internal/uniquestring"
 	"github.com/opctl/opctl/sdks/go/model"
 	"github.com/opctl/opctl/sdks/go/opspec/interpreter/call/op/inputs"
 	"github.com/opctl/opctl/sdks/go/opspec/interpreter/dir"
 	"github.com/opctl/opctl/sdks/go/opspec/interpreter/str"
 	"github.com/opctl/opctl/sdks/go/opspec/opfile"
+	"github.com/opctl/opctl/sdks/go/opspec/signature"
 	"github.com/pkg/errors"
 )
 
@@ -47,7 +51,11 @@ func Interpret(
 		pkgPullCreds.Password = *interpretdPassword.String
 	}
 
-	var opPath string
+	var (
+		opCommit string
+		opPath   string
+		opRef    string
+	)
 	if regexp.MustCompile("^\\$\\(.+\\)$").MatchString(opCallSpec.Ref) {
 		// attempt to process as a variable reference since its variable reference like.
 		dirValue, err := dir.Interpret(
@@ -65,12 +73,27 @@ func Interpret(
 			ctx,
 			opCallSpec.Ref,
 			fs.New(parentOpPath, filepath.Dir(parentOpPath)),
+			tarball.New(filepath.Join(dataDirPath, "ops"), pkgPullCreds),
 			git.New(filepath.Join(dataDirPath, "ops"), pkgPullCreds),
+			oci.New(filepath.Join(dataDirPath, "ops"), pkgPullCreds),
 		)
 		if err != nil {
 			return nil, err
 		}
 		opPath = *opHandle.Path()
+		opRef = opHandle.Ref()
+
+		if err := signature.EnforcePolicy(
+			opPath,
+			filepath.Join(dataDirPath, "ops"),
+			filepath.Join(dataDirPath, signature.TrustedKeysDirName),
+		); err != nil {
+			return nil, err
+		}
+
+		if committedHandle, ok := opHandle.(interface{ Commit() string }); ok {
+			opCommit = committedHandle.Commit()
+		}
 	}
 
 	opFile, err := opfile.Get(
@@ -92,6 +115,8 @@ func Interpret(
 		},
 		ChildCallID:       childCallID,
 		ChildCallCallSpec: opFile.Run,
+		Commit:            opCommit,
+		Ref:               opRef,
 		OpID:              opID,
 	}
 
@@ -106,5 +131,7 @@ func Interpret(
 		return nil, errors.Wrap(err, fmt.Sprintf("unable to interpret call to %v", opCallSpec.Ref))
 	}
 
+	opCall.Secrets = redact.Secrets(opFile.Inputs, opCall.Inputs)
+
 	return opCall, nil
 }
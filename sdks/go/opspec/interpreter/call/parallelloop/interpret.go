@@ -1,8 +1,12 @@
 package parallelloop
 
 import (
+	"fmt"
+
 	"github.com/opctl/opctl/sdks/go/model"
+	"github.com/opctl/opctl/sdks/go/opspec/interpreter/call/loop/matrix"
 	"github.com/opctl/opctl/sdks/go/opspec/interpreter/loopable"
+	"github.com/opctl/opctl/sdks/go/opspec/interpreter/number"
 )
 
 //Interpret a parallel Loop
@@ -12,7 +16,31 @@ func Interpret(
 ) (*model.ParallelLoopCall, error) {
 	parallelLoopCall := model.ParallelLoopCall{}
 
-	loopRangeSpec := parallelLoopCallSpec.Range
+	if parallelLoopCallSpec.MaxConcurrency != nil {
+		maxConcurrencyValue, err := number.Interpret(
+			scope,
+			parallelLoopCallSpec.MaxConcurrency,
+		)
+		if err != nil {
+			return nil, err
+		}
+
+		maxConcurrency := int(*maxConcurrencyValue.Number)
+		if float64(maxConcurrency) != *maxConcurrencyValue.Number || maxConcurrency < 1 {
+			return nil, fmt.Errorf("unable to interpret %v to maxConcurrency: must be a positive integer", *maxConcurrencyValue.Number)
+		}
+
+		parallelLoopCall.MaxConcurrency = &maxConcurrency
+	}
+
+	loopRangeSpec, err := RangeSpec(
+		parallelLoopCallSpec,
+		scope,
+	)
+	if err != nil {
+		return nil, err
+	}
+
 	if loopRangeSpec != nil {
 		dcgLoopRange, err := loopable.Interpret(
 			loopRangeSpec,
@@ -27,3 +55,24 @@ func Interpret(
 
 	return &parallelLoopCall, nil
 }
+
+// RangeSpec returns the range iterations of a parallel loop range over; a matrix is interpreted to the
+// array of combinations it ranges over, otherwise the range is returned as is
+func RangeSpec(
+	parallelLoopCallSpec model.ParallelLoopCallSpec,
+	scope map[string]*model.Value,
+) (interface{}, error) {
+	if parallelLoopCallSpec.Matrix == nil {
+		return parallelLoopCallSpec.Range, nil
+	}
+
+	matrixRange, err := matrix.Interpret(
+		parallelLoopCallSpec.Matrix,
+		scope,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return *matrixRange, nil
+}
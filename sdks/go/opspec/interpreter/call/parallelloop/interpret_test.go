@@ -24,6 +24,64 @@ var _ = Context("Interpret", func() {
 			Expect(actualError).To(MatchError("unable to coerce string to object: invalid character 'r' looking for beginning of value"))
 		})
 	})
+	Context("maxConcurrency not a positive integer", func() {
+		It("should return expected result", func() {
+			/* arrange */
+			/* act */
+			_, actualError := Interpret(
+				model.ParallelLoopCallSpec{
+					MaxConcurrency: 0.5,
+					Range:          []interface{}{},
+				},
+				map[string]*model.Value{},
+			)
+
+			/* assert */
+			Expect(actualError).To(MatchError("unable to interpret 0.5 to maxConcurrency: must be a positive integer"))
+		})
+	})
+	Context("maxConcurrency a reference to a positive integer", func() {
+		It("should return expected result", func() {
+			/* arrange */
+			maxConcurrency := 2.0
+			providedScope := map[string]*model.Value{
+				"maxConcurrency": {Number: &maxConcurrency},
+			}
+
+			/* act */
+			actualResult, actualError := Interpret(
+				model.ParallelLoopCallSpec{
+					MaxConcurrency: "$(maxConcurrency)",
+					Range:          []interface{}{},
+				},
+				providedScope,
+			)
+
+			/* assert */
+			Expect(actualError).To(BeNil())
+			Expect(*actualResult.MaxConcurrency).To(Equal(2))
+		})
+	})
+	Context("matrix", func() {
+		It("should return expected result", func() {
+			/* arrange/act */
+			actualResult, actualError := Interpret(
+				model.ParallelLoopCallSpec{
+					Matrix: map[string]interface{}{
+						"os": []interface{}{"linux", "windows"},
+					},
+				},
+				map[string]*model.Value{},
+			)
+
+			/* assert */
+			Expect(actualError).To(BeNil())
+			Expect(*actualResult.Range.Array).To(Equal([]interface{}{
+				map[string]interface{}{"os": "linux"},
+				map[string]interface{}{"os": "windows"},
+			}))
+		})
+	})
 	It("should return expected result", func() {
 		/* arrange */
 		identifier := "identifier"
@@ -48,3 +106,43 @@ var _ = Context("Interpret", func() {
 		))
 	})
 })
+
+var _ = Context("RangeSpec", func() {
+	Context("matrix", func() {
+		It("should return combinations of matrix", func() {
+			/* act */
+			actualRangeSpec, actualError := RangeSpec(
+				model.ParallelLoopCallSpec{
+					Matrix: map[string]interface{}{
+						"os": []interface{}{"linux", "windows"},
+					},
+				},
+				map[string]*model.Value{},
+			)
+
+			/* assert */
+			Expect(actualError).To(BeNil())
+			Expect(actualRangeSpec).To(Equal(model.Value{
+				Array: &[]interface{}{
+					map[string]interface{}{"os": "linux"},
+					map[string]interface{}{"os": "windows"},
+				},
+			}))
+		})
+	})
+	Context("range", func() {
+		It("should return range as is", func() {
+			/* act */
+			actualRangeSpec, actualError := RangeSpec(
+				model.ParallelLoopCallSpec{
+					Range: "$(range)",
+				},
+				map[string]*model.Value{},
+			)
+
+			/* assert */
+			Expect(actualError).To(BeNil())
+			Expect(actualRangeSpec).To(Equal("$(range)"))
+		})
+	})
+})
@@ -1,9 +1,15 @@
 package serialloop
 
 import (
+	"fmt"
+	"time"
+
 	"github.com/opctl/opctl/sdks/go/model"
+	"github.com/opctl/opctl/sdks/go/opspec/interpreter/call/loop/matrix"
 	"github.com/opctl/opctl/sdks/go/opspec/interpreter/call/predicates"
 	"github.com/opctl/opctl/sdks/go/opspec/interpreter/loopable"
+	"github.com/opctl/opctl/sdks/go/opspec/interpreter/number"
+	"github.com/opctl/opctl/sdks/go/opspec/interpreter/str"
 )
 
 //Interpret a serial loop
@@ -13,7 +19,46 @@ func Interpret(
 ) (*model.SerialLoopCall, error) {
 	dcgSerialLoop := model.SerialLoopCall{}
 
-	loopRangeSpec := serialLoopCallSpec.Range
+	if serialLoopCallSpec.Delay != nil {
+		delayValue, err := str.Interpret(
+			scope,
+			serialLoopCallSpec.Delay,
+		)
+		if err != nil {
+			return nil, err
+		}
+
+		delay, err := time.ParseDuration(*delayValue.String)
+		if err != nil || delay < 0 {
+			return nil, fmt.Errorf("unable to interpret '%v' to delay: must be a non negative duration such as '5s'", *delayValue.String)
+		}
+		dcgSerialLoop.Delay = &delay
+	}
+
+	if serialLoopCallSpec.MaxIterations != nil {
+		maxIterationsValue, err := number.Interpret(
+			scope,
+			serialLoopCallSpec.MaxIterations,
+		)
+		if err != nil {
+			return nil, err
+		}
+
+		maxIterations := int(*maxIterationsValue.Number)
+		if float64(maxIterations) != *maxIterationsValue.Number || maxIterations < 1 {
+			return nil, fmt.Errorf("unable to interpret %v to maxIterations: must be a positive integer", *maxIterationsValue.Number)
+		}
+		dcgSerialLoop.MaxIterations = &maxIterations
+	}
+
+	loopRangeSpec, err := RangeSpec(
+		serialLoopCallSpec,
+		scope,
+	)
+	if err != nil {
+		return nil, err
+	}
+
 	if loopRangeSpec != nil {
 		dcgLoopRange, err := loopable.Interpret(
 			loopRangeSpec,
@@ -41,3 +86,24 @@ func Interpret(
 
 	return &dcgSerialLoop, nil
 }
+
+// RangeSpec returns the range iterations of a serial loop range over; a matrix is interpreted to the
+// array of combinations it ranges over, otherwise the range is returned as is
+func RangeSpec(
+	serialLoopCallSpec model.SerialLoopCallSpec,
+	scope map[string]*model.Value,
+) (interface{}, error) {
+	if serialLoopCallSpec.Matrix == nil {
+		return serialLoopCallSpec.Range, nil
+	}
+
+	matrixRange, err := matrix.Interpret(
+		serialLoopCallSpec.Matrix,
+		scope,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return *matrixRange, nil
+}
@@ -0,0 +1,108 @@
+package serialloop
+
+import (
+	"time"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/opctl/opctl/sdks/go/model"
+)
+
+var _ = Context("Interpret", func() {
+	Context("delay not a duration", func() {
+		It("should return expected result", func() {
+			/* arrange/act */
+			_, actualError := Interpret(
+				model.SerialLoopCallSpec{
+					Delay: "soon",
+				},
+				map[string]*model.Value{},
+			)
+
+			/* assert */
+			Expect(actualError).To(MatchError("unable to interpret 'soon' to delay: must be a non negative duration such as '5s'"))
+		})
+	})
+	Context("maxIterations not a positive integer", func() {
+		It("should return expected result", func() {
+			/* arrange/act */
+			_, actualError := Interpret(
+				model.SerialLoopCallSpec{
+					MaxIterations: 0,
+				},
+				map[string]*model.Value{},
+			)
+
+			/* assert */
+			Expect(actualError).To(MatchError("unable to interpret 0 to maxIterations: must be a positive integer"))
+		})
+	})
+	Context("delay & maxIterations references", func() {
+		It("should return expected result", func() {
+			/* arrange */
+			delay := "1m30s"
+			maxIterations := 10.0
+			expectedDelay := 90 * time.Second
+			expectedMaxIterations := 10
+
+			/* act */
+			actualResult, actualError := Interpret(
+				model.SerialLoopCallSpec{
+					Delay:         "$(delay)",
+					MaxIterations: "$(maxIterations)",
+				},
+				map[string]*model.Value{
+					"delay":         {String: &delay},
+					"maxIterations": {Number: &maxIterations},
+				},
+			)
+
+			/* assert */
+			Expect(actualError).To(BeNil())
+			Expect(*actualResult).To(Equal(model.SerialLoopCall{
+				Delay:         &expectedDelay,
+				MaxIterations: &expectedMaxIterations,
+			}))
+		})
+	})
+})
+
+var _ = Context("RangeSpec", func() {
+	Context("matrix", func() {
+		It("should return combinations of matrix", func() {
+			/* act */
+			actualRangeSpec, actualError := RangeSpec(
+				model.SerialLoopCallSpec{
+					Matrix: map[string]interface{}{
+						"os": []interface{}{"linux", "windows"},
+					},
+				},
+				map[string]*model.Value{},
+			)
+
+			/* assert */
+			Expect(actualError).To(BeNil())
+			Expect(actualRangeSpec).To(Equal(model.Value{
+				Array: &[]interface{}{
+					map[string]interface{}{"os": "linux"},
+					map[string]interface{}{"os": "windows"},
+				},
+			}))
+		})
+	})
+	Context("range", func() {
+		It("should return range as is", func() {
+			/* act */
+			actualRangeSpec, actualError := RangeSpec(
+				model.SerialLoopCallSpec{
+					Range: "$(range)",
+				},
+				map[string]*model.Value{},
+			)
+
+			/* assert */
+			Expect(actualError).To(BeNil())
+			Expect(actualRangeSpec).To(Equal("$(range)"))
+		})
+	})
+})
@@ -0,0 +1,37 @@
+package switchcall
+
+import (
+	"fmt"
+
+	"github.com/opctl/opctl/sdks/go/model"
+	"github.com/opctl/opctl/sdks/go/opspec/interpreter/call/predicates"
+	"github.com/pkg/errors"
+)
+
+//Interpret a switch; the first case whose predicates are all true is matched, otherwise the default
+func Interpret(
+	switchCallSpec model.SwitchCallSpec,
+	scope map[string]*model.Value,
+) (*model.SwitchCall, error) {
+	for caseIndex, caseSpec := range switchCallSpec.Cases {
+		isMatch, err := predicates.Interpret(
+			caseSpec.If,
+			scope,
+		)
+		if err != nil {
+			return nil, errors.Wrap(err, fmt.Sprintf("unable to interpret case %v", caseIndex))
+		}
+
+		if isMatch {
+			matchedCaseIndex := caseIndex
+			return &model.SwitchCall{
+				Case: &matchedCaseIndex,
+				Run:  &caseSpec.Run,
+			}, nil
+		}
+	}
+
+	return &model.SwitchCall{
+		Run: switchCallSpec.Default,
+	}, nil
+}
@@ -0,0 +1,74 @@
+package switchcall
+
+import (
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/opctl/opctl/sdks/go/model"
+)
+
+var _ = Context("Interpret", func() {
+	isTrue := []interface{}{true, true}
+	isFalse := []interface{}{true, false}
+	firstRun := model.CallSpec{Name: new(string)}
+	secondRun := model.CallSpec{Name: new(string)}
+	defaultRun := &model.CallSpec{Name: new(string)}
+
+	Context("multiple cases match", func() {
+		It("should return first matched case", func() {
+			/* act */
+			actualSwitchCall, actualErr := Interpret(
+				model.SwitchCallSpec{
+					Cases: []*model.SwitchCaseSpec{
+						{If: []*model.PredicateSpec{{Eq: &isFalse}}, Run: model.CallSpec{}},
+						{If: []*model.PredicateSpec{{Eq: &isTrue}}, Run: firstRun},
+						{If: []*model.PredicateSpec{{Eq: &isTrue}}, Run: secondRun},
+					},
+					Default: defaultRun,
+				},
+				map[string]*model.Value{},
+			)
+
+			/* assert */
+			Expect(actualErr).To(BeNil())
+			Expect(*actualSwitchCall.Case).To(Equal(1))
+			Expect(actualSwitchCall.Run).To(Equal(&firstRun))
+		})
+	})
+	Context("no case matches", func() {
+		It("should return default", func() {
+			/* act */
+			actualSwitchCall, actualErr := Interpret(
+				model.SwitchCallSpec{
+					Cases: []*model.SwitchCaseSpec{
+						{If: []*model.PredicateSpec{{Eq: &isFalse}}, Run: firstRun},
+					},
+					Default: defaultRun,
+				},
+				map[string]*model.Value{},
+			)
+
+			/* assert */
+			Expect(actualErr).To(BeNil())
+			Expect(actualSwitchCall).To(Equal(&model.SwitchCall{Run: defaultRun}))
+		})
+	})
+	Context("predicate errs", func() {
+		It("should return expected error", func() {
+			/* arrange */
+			unresolvable := []interface{}{"$(doesNotExist)", true}
+
+			/* act */
+			_, actualErr := Interpret(
+				model.SwitchCallSpec{
+					Cases: []*model.SwitchCaseSpec{
+						{If: []*model.PredicateSpec{{Eq: &unresolvable}}, Run: firstRun},
+					},
+				},
+				map[string]*model.Value{},
+			)
+
+			/* assert */
+			Expect(actualErr).To(MatchError(HavePrefix("unable to interpret case 0: ")))
+		})
+	})
+})
@@ -0,0 +1,2 @@
+// Package switchcall exposes functionality for interpreting switch calls; i.e. calls of the first case whose predicates are all true.
+package switchcall
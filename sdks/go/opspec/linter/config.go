@@ -0,0 +1,87 @@
+package linter
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+
+	"github.com/ghodss/yaml"
+)
+
+// ConfigFileName is the conventional name of a lint config file; typically placed in the .opspec dir
+const ConfigFileName = "lint.yml"
+
+// Severity of a rule
+type Severity string
+
+const (
+	// SeverityError problems fail linting
+	SeverityError Severity = "error"
+	// SeverityWarning problems are reported but don't fail linting
+	SeverityWarning Severity = "warning"
+	// SeverityIgnore disables a rule
+	SeverityIgnore Severity = "ignore"
+)
+
+// Config configures a lint
+type Config struct {
+	// Rules overrides the default severity of rules; format: ruleName => severity
+	Rules map[string]Severity `json:"rules,omitempty"`
+}
+
+// GetConfig gets the lint config at path;
+// if no file exists at path, an empty config (all rules at their default severity) is returned
+func GetConfig(
+	path string,
+) (*Config, error) {
+	configBytes, err := ioutil.ReadFile(path)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return &Config{}, nil
+		}
+		return nil, err
+	}
+
+	config, err := UnmarshalConfig(configBytes)
+	if err != nil {
+		return nil, fmt.Errorf("invalid lint config '%v': %v", path, err)
+	}
+
+	return config, nil
+}
+
+// UnmarshalConfig validates and unmarshals a lint config
+func UnmarshalConfig(
+	configBytes []byte,
+) (*Config, error) {
+	config := Config{}
+	if err := yaml.Unmarshal(configBytes, &config); err != nil {
+		return nil, err
+	}
+
+	for ruleName, severity := range config.Rules {
+		if _, ok := rulesByName[ruleName]; !ok {
+			return nil, fmt.Errorf("unknown rule '%v'", ruleName)
+		}
+
+		switch severity {
+		case SeverityError, SeverityWarning, SeverityIgnore:
+		default:
+			return nil, fmt.Errorf("rule '%v' has invalid severity '%v'; expected one of '%v', '%v', or '%v'", ruleName, severity, SeverityError, SeverityWarning, SeverityIgnore)
+		}
+	}
+
+	return &config, nil
+}
+
+// severityOf returns the configured severity of rule, falling back to its default
+func (c *Config) severityOf(
+	ruleName string,
+) Severity {
+	if c != nil {
+		if severity, ok := c.Rules[ruleName]; ok {
+			return severity
+		}
+	}
+	return rulesByName[ruleName].defaultSeverity
+}
@@ -0,0 +1,68 @@
+package linter
+
+import (
+	"os"
+	"path/filepath"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Context("GetConfig", func() {
+	Context("file doesn't exist", func() {
+		It("should return empty config", func() {
+			/* act */
+			actualConfig, actualErr := GetConfig(filepath.Join(os.TempDir(), "doesNotExist", ConfigFileName))
+
+			/* assert */
+			Expect(actualErr).To(BeNil())
+			Expect(*actualConfig).To(Equal(Config{}))
+		})
+	})
+})
+
+var _ = Context("UnmarshalConfig", func() {
+	Context("valid", func() {
+		It("should return expected result", func() {
+			/* act */
+			actualConfig, actualErr := UnmarshalConfig([]byte(`
+rules:
+  unused-input: error
+  untagged-image: ignore
+`))
+
+			/* assert */
+			Expect(actualErr).To(BeNil())
+			Expect(*actualConfig).To(Equal(Config{
+				Rules: map[string]Severity{
+					RuleUnusedInput:   SeverityError,
+					RuleUntaggedImage: SeverityIgnore,
+				},
+			}))
+		})
+	})
+	Context("unknown rule", func() {
+		It("should return expected error", func() {
+			/* act */
+			_, actualErr := UnmarshalConfig([]byte(`
+rules:
+  not-a-rule: error
+`))
+
+			/* assert */
+			Expect(actualErr).To(MatchError("unknown rule 'not-a-rule'"))
+		})
+	})
+	Context("invalid severity", func() {
+		It("should return expected error", func() {
+			/* act */
+			_, actualErr := UnmarshalConfig([]byte(`
+rules:
+  unused-input: fatal
+`))
+
+			/* assert */
+			Expect(actualErr).To(MatchError("rule 'unused-input' has invalid severity 'fatal'; expected one of 'error', 'warning', or 'ignore'"))
+		})
+	})
+})
@@ -0,0 +1,102 @@
+package linter
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/opctl/opctl/sdks/go/model"
+	"github.com/opctl/opctl/sdks/go/opspec/opfile"
+)
+
+// Lint an "op.yml" file against all rules at the severity configured by config (nil config implies defaults).
+// An error is returned if the op.yml is invalid; problems are returned sorted by path.
+func Lint(
+	opFileBytes []byte,
+	config *Config,
+) ([]*Problem, error) {
+	opSpec, err := opfile.Unmarshal(opFileBytes)
+	if err != nil {
+		return nil, err
+	}
+
+	problems := []*Problem{}
+	for _, ruleName := range sortedRuleNames() {
+		severity := config.severityOf(ruleName)
+		if severity == SeverityIgnore {
+			continue
+		}
+
+		rulesByName[ruleName].check(
+			opSpec,
+			func(path string, format string, args ...interface{}) {
+				problems = append(
+					problems,
+					&Problem{
+						Path:     path,
+						Rule:     ruleName,
+						Severity: severity,
+						Message:  fmt.Sprintf(format, args...),
+					},
+				)
+			},
+		)
+	}
+
+	sort.SliceStable(problems, func(i, j int) bool {
+		return problems[i].Path < problems[j].Path
+	})
+
+	return problems, nil
+}
+
+// report reports a problem at path
+type report func(path string, format string, args ...interface{})
+
+// walkCalls calls visit for callSpec & each of its descendants, depth first
+func walkCalls(
+	path string,
+	callSpec *model.CallSpec,
+	visit func(path string, callSpec *model.CallSpec),
+) {
+	if callSpec == nil {
+		return
+	}
+
+	visit(path, callSpec)
+
+	switch {
+	case callSpec.Dag != nil:
+		for i, childCallSpec := range *callSpec.Dag {
+			walkCalls(fmt.Sprintf("%v.dag[%v]", path, i), childCallSpec, visit)
+		}
+	case callSpec.Parallel != nil:
+		for i, childCallSpec := range *callSpec.Parallel {
+			walkCalls(fmt.Sprintf("%v.parallel[%v]", path, i), childCallSpec, visit)
+		}
+	case callSpec.ParallelLoop != nil:
+		walkCalls(path+".parallelLoop.run", &callSpec.ParallelLoop.Run, visit)
+	case callSpec.Serial != nil:
+		for i, childCallSpec := range *callSpec.Serial {
+			walkCalls(fmt.Sprintf("%v.serial[%v]", path, i), childCallSpec, visit)
+		}
+	case callSpec.SerialLoop != nil:
+		walkCalls(path+".serialLoop.run", &callSpec.SerialLoop.Run, visit)
+	case callSpec.Switch != nil:
+		for i, caseSpec := range callSpec.Switch.Cases {
+			walkCalls(fmt.Sprintf("%v.switch.cases[%v].run", path, i), &caseSpec.Run, visit)
+		}
+		walkCalls(path+".switch.default", callSpec.Switch.Default, visit)
+	}
+
+	walkCalls(path+".else", callSpec.Else, visit)
+	walkCalls(path+".finally", callSpec.Finally, visit)
+}
+
+// walkOpCalls calls walkCalls for the run & finally calls of opSpec
+func walkOpCalls(
+	opSpec *model.OpSpec,
+	visit func(path string, callSpec *model.CallSpec),
+) {
+	walkCalls("run", opSpec.Run, visit)
+	walkCalls("finally", opSpec.Finally, visit)
+}
@@ -0,0 +1,200 @@
+package linter
+
+import (
+	"io/ioutil"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Context("Lint", func() {
+	Context("op.yml invalid", func() {
+		It("should return err", func() {
+			/* act */
+			_, actualErr := Lint([]byte("name: []"), nil)
+
+			/* assert */
+			Expect(actualErr).To(Not(BeNil()))
+		})
+	})
+	Context("op has no problems", func() {
+		It("should return no problems", func() {
+			/* arrange */
+			opFileBytes, err := ioutil.ReadFile("testdata/clean/op.yml")
+			if err != nil {
+				panic(err)
+			}
+
+			/* act */
+			actualProblems, actualErr := Lint(opFileBytes, nil)
+
+			/* assert */
+			Expect(actualErr).To(BeNil())
+			Expect(actualProblems).To(BeEmpty())
+		})
+	})
+	Context("inputs only used in loop matrices", func() {
+		It("should return no problems", func() {
+			/* arrange */
+			opFileBytes := []byte(`
+name: matrix
+description: an op looping over matrices
+inputs:
+  oses:
+    description: oses to build for
+    array:
+      default: [linux, darwin]
+  arches:
+    description: arches to build for
+    array:
+      default: [amd64, arm64]
+run:
+  serial:
+    - parallelLoop:
+        matrix:
+          os: $(oses)
+        run:
+          container:
+            image:
+              ref: alpine:3.12
+    - serialLoop:
+        matrix:
+          arch: $(arches)
+        run:
+          container:
+            image:
+              ref: alpine:3.12
+`)
+
+			/* act */
+			actualProblems, actualErr := Lint(opFileBytes, nil)
+
+			/* assert */
+			Expect(actualErr).To(BeNil())
+			Expect(actualProblems).To(BeEmpty())
+		})
+	})
+	Context("inputs only used in serialLoop maxIterations & delay", func() {
+		It("should return no problems", func() {
+			/* arrange */
+			opFileBytes := []byte(`
+name: poll
+description: an op polling until ready
+inputs:
+  maxAttempts:
+    description: attempts before giving up
+    number:
+      default: 10
+  interval:
+    description: time between attempts
+    string:
+      default: 5s
+run:
+  serialLoop:
+    maxIterations: $(maxAttempts)
+    delay: $(interval)
+    until:
+      - exists: $(ready)
+    run:
+      container:
+        image:
+          ref: alpine:3.12
+        files:
+          /ready: $(ready)
+`)
+
+			/* act */
+			actualProblems, actualErr := Lint(opFileBytes, nil)
+
+			/* assert */
+			Expect(actualErr).To(BeNil())
+			Expect(actualProblems).To(BeEmpty())
+		})
+	})
+	Context("op violates rules", func() {
+		var opFileBytes []byte
+		BeforeEach(func() {
+			var err error
+			opFileBytes, err = ioutil.ReadFile("testdata/violations/op.yml")
+			if err != nil {
+				panic(err)
+			}
+		})
+		Context("nil config", func() {
+			It("should return expected problems at default severities", func() {
+				/* act */
+				actualProblems, actualErr := Lint(opFileBytes, nil)
+
+				/* assert */
+				Expect(actualErr).To(BeNil())
+				Expect(actualProblems).To(Equal([]*Problem{
+					{
+						Path:     "inputs.deprecated.string.description",
+						Rule:     RuleDeprecatedParamDescription,
+						Severity: SeverityWarning,
+						Message:  "'string.description' is deprecated; use 'description'",
+					},
+					{
+						Path:     "inputs.secret.string.default",
+						Rule:     RuleSecretDefault,
+						Severity: SeverityError,
+						Message:  "secret 'secret' has a default; secrets shouldn't be committed to op.yml",
+					},
+					{
+						Path:     "inputs.undescribed",
+						Rule:     RuleInputDescription,
+						Severity: SeverityWarning,
+						Message:  "input 'undescribed' has no description",
+					},
+					{
+						Path:     "inputs.unused",
+						Rule:     RuleUnusedInput,
+						Severity: SeverityWarning,
+						Message:  "input 'unused' is never referenced",
+					},
+					{
+						Path:     "run.parallel[0].container.image.ref",
+						Rule:     RuleUntaggedImage,
+						Severity: SeverityWarning,
+						Message:  "image 'docker.io/library/alpine' has no tag; pin a tag or digest so runs are repeatable",
+					},
+					{
+						Path:     "run.parallel[1].op.outputs.result",
+						Rule:     RuleObsoleteOutputBinding,
+						Severity: SeverityWarning,
+						Message:  "'result: out' uses obsolete output binding syntax; use 'out: $(result)'",
+					},
+				}))
+			})
+		})
+		Context("config overrides severities", func() {
+			It("should return problems at configured severities", func() {
+				/* arrange */
+				providedConfig := &Config{
+					Rules: map[string]Severity{
+						RuleDeprecatedParamDescription: SeverityIgnore,
+						RuleInputDescription:           SeverityIgnore,
+						RuleObsoleteOutputBinding:      SeverityIgnore,
+						RuleSecretDefault:              SeverityIgnore,
+						RuleUntaggedImage:              SeverityIgnore,
+						RuleUnusedInput:                SeverityError,
+					},
+				}
+
+				/* act */
+				actualProblems, actualErr := Lint(opFileBytes, providedConfig)
+
+				/* assert */
+				Expect(actualErr).To(BeNil())
+				Expect(actualProblems).To(Equal([]*Problem{
+					{
+						Path:     "inputs.unused",
+						Rule:     RuleUnusedInput,
+						Severity: SeverityError,
+						Message:  "input 'unused' is never referenced",
+					},
+				}))
+			})
+		})
+	})
+})
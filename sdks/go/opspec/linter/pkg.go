@@ -0,0 +1,2 @@
+// Package linter implements linting of ops; i.e. enforcement of house style beyond validity
+package linter
@@ -0,0 +1,18 @@
+package linter
+
+import (
+	"fmt"
+)
+
+// Problem is a violation of a rule
+type Problem struct {
+	// Path of the offending element within the op.yml e.g. "run.serial[0].container.image.ref"
+	Path     string
+	Rule     string
+	Severity Severity
+	Message  string
+}
+
+func (p Problem) String() string {
+	return fmt.Sprintf("%v: %v (%v)", p.Path, p.Message, p.Rule)
+}
@@ -0,0 +1,368 @@
+package linter
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/opctl/opctl/sdks/go/model"
+	"github.com/opctl/opctl/sdks/go/opspec/interpreter/reference/identifier/unbracketed"
+)
+
+const (
+	// RuleDeprecatedParamDescription flags use of the deprecated param.<datatype>.description
+	RuleDeprecatedParamDescription = "deprecated-param-description"
+	// RuleInputDescription flags inputs w/out a description
+	RuleInputDescription = "input-description"
+	// RuleObsoleteOutputBinding flags op call outputs bound via the obsolete `scopeName: outputName` syntax
+	RuleObsoleteOutputBinding = "obsolete-output-binding"
+	// RuleSecretDefault flags secret params w/ a default
+	RuleSecretDefault = "secret-default"
+	// RuleUntaggedImage flags container images referenced w/out a tag or digest
+	RuleUntaggedImage = "untagged-image"
+	// RuleUnusedInput flags inputs which aren't referenced
+	RuleUnusedInput = "unused-input"
+)
+
+type rule struct {
+	defaultSeverity Severity
+	check           func(opSpec *model.OpSpec, report report)
+}
+
+var rulesByName = map[string]rule{
+	RuleDeprecatedParamDescription: {
+		defaultSeverity: SeverityWarning,
+		check:           checkDeprecatedParamDescription,
+	},
+	RuleInputDescription: {
+		defaultSeverity: SeverityWarning,
+		check:           checkInputDescription,
+	},
+	RuleObsoleteOutputBinding: {
+		defaultSeverity: SeverityWarning,
+		check:           checkObsoleteOutputBinding,
+	},
+	RuleSecretDefault: {
+		defaultSeverity: SeverityError,
+		check:           checkSecretDefault,
+	},
+	RuleUntaggedImage: {
+		defaultSeverity: SeverityWarning,
+		check:           checkUntaggedImage,
+	},
+	RuleUnusedInput: {
+		defaultSeverity: SeverityWarning,
+		check:           checkUnusedInput,
+	},
+}
+
+func sortedRuleNames() []string {
+	ruleNames := []string{}
+	for ruleName := range rulesByName {
+		ruleNames = append(ruleNames, ruleName)
+	}
+	sort.Strings(ruleNames)
+	return ruleNames
+}
+
+func sortedParamNames(params map[string]*model.Param) []string {
+	paramNames := []string{}
+	for paramName := range params {
+		paramNames = append(paramNames, paramName)
+	}
+	sort.Strings(paramNames)
+	return paramNames
+}
+
+// paramAttrs are the datatype specific attributes of a param
+type paramAttrs struct {
+	dataType string
+	// deprecated
+	description string
+	hasDefault  bool
+	isSecret    bool
+}
+
+func getParamAttrs(
+	param *model.Param,
+) paramAttrs {
+	switch {
+	case param.Array != nil:
+		return paramAttrs{"array", param.Array.Description, param.Array.Default != nil, param.Array.IsSecret}
+	case param.Boolean != nil:
+		return paramAttrs{"boolean", param.Boolean.Description, param.Boolean.Default != nil, false}
+	case param.Dir != nil:
+		return paramAttrs{"dir", param.Dir.Description, param.Dir.Default != nil, param.Dir.IsSecret}
+	case param.File != nil:
+		return paramAttrs{"file", param.File.Description, param.File.Default != nil, param.File.IsSecret}
+	case param.Number != nil:
+		return paramAttrs{"number", param.Number.Description, param.Number.Default != nil, param.Number.IsSecret}
+	case param.Object != nil:
+		return paramAttrs{"object", param.Object.Description, param.Object.Default != nil, param.Object.IsSecret}
+	case param.Socket != nil:
+		return paramAttrs{"socket", param.Socket.Description, false, param.Socket.IsSecret}
+	case param.String != nil:
+		return paramAttrs{"string", param.String.Description, param.String.Default != nil, param.String.IsSecret}
+	default:
+		return paramAttrs{}
+	}
+}
+
+func checkDeprecatedParamDescription(
+	opSpec *model.OpSpec,
+	report report,
+) {
+	for kind, params := range map[string]map[string]*model.Param{"inputs": opSpec.Inputs, "outputs": opSpec.Outputs} {
+		for _, name := range sortedParamNames(params) {
+			attrs := getParamAttrs(params[name])
+			if attrs.description != "" {
+				report(
+					fmt.Sprintf("%v.%v.%v.description", kind, name, attrs.dataType),
+					"'%v.description' is deprecated; use 'description'",
+					attrs.dataType,
+				)
+			}
+		}
+	}
+}
+
+func checkInputDescription(
+	opSpec *model.OpSpec,
+	report report,
+) {
+	for _, name := range sortedParamNames(opSpec.Inputs) {
+		param := opSpec.Inputs[name]
+		if param.Description == "" && getParamAttrs(param).description == "" {
+			report("inputs."+name, "input '%v' has no description", name)
+		}
+	}
+}
+
+var refRegexp = regexp.MustCompile(`^\$\(.+\)$`)
+
+func checkObsoleteOutputBinding(
+	opSpec *model.OpSpec,
+	report report,
+) {
+	walkOpCalls(
+		opSpec,
+		func(path string, callSpec *model.CallSpec) {
+			if callSpec.Op == nil {
+				return
+			}
+
+			for _, name := range sortedKeys(callSpec.Op.Outputs) {
+				boundValue := callSpec.Op.Outputs[name]
+				if boundValue != "" && !refRegexp.MatchString(boundValue) {
+					report(
+						fmt.Sprintf("%v.op.outputs.%v", path, name),
+						"'%v: %v' uses obsolete output binding syntax; use '%v: $(%v)'",
+						name,
+						boundValue,
+						boundValue,
+						name,
+					)
+				}
+			}
+		},
+	)
+}
+
+func checkSecretDefault(
+	opSpec *model.OpSpec,
+	report report,
+) {
+	for kind, params := range map[string]map[string]*model.Param{"inputs": opSpec.Inputs, "outputs": opSpec.Outputs} {
+		for _, name := range sortedParamNames(params) {
+			attrs := getParamAttrs(params[name])
+			if attrs.isSecret && attrs.hasDefault {
+				report(
+					fmt.Sprintf("%v.%v.%v.default", kind, name, attrs.dataType),
+					"secret '%v' has a default; secrets shouldn't be committed to op.yml",
+					name,
+				)
+			}
+		}
+	}
+}
+
+func checkUntaggedImage(
+	opSpec *model.OpSpec,
+	report report,
+) {
+	walkOpCalls(
+		opSpec,
+		func(path string, callSpec *model.CallSpec) {
+			if callSpec.Container == nil || callSpec.Container.Image == nil {
+				return
+			}
+
+			imageRef := callSpec.Container.Image.Ref
+			if strings.Contains(imageRef, "$(") {
+				// can't determine statically
+				return
+			}
+
+			if strings.Contains(imageRef, "@") {
+				// digest
+				return
+			}
+
+			if strings.Contains(imageRef[strings.LastIndex(imageRef, "/")+1:], ":") {
+				// tag
+				return
+			}
+
+			report(
+				path+".container.image.ref",
+				"image '%v' has no tag; pin a tag or digest so runs are repeatable",
+				imageRef,
+			)
+		},
+	)
+}
+
+func checkUnusedInput(
+	opSpec *model.OpSpec,
+	report report,
+) {
+	referenced := map[string]bool{}
+	walkOpCalls(
+		opSpec,
+		func(path string, callSpec *model.CallSpec) {
+			addReferencedIdentifiers(callSpec, referenced)
+		},
+	)
+
+	for _, name := range sortedParamNames(opSpec.Inputs) {
+		if !referenced[name] {
+			report("inputs."+name, "input '%v' is never referenced", name)
+		}
+	}
+}
+
+// addReferencedIdentifiers adds the identifiers of all scope refs made directly by callSpec (not its children) to identifiers
+func addReferencedIdentifiers(
+	callSpec *model.CallSpec,
+	identifiers map[string]bool,
+) {
+	addPredicates := func(predicates []*model.PredicateSpec) {
+		for _, predicate := range predicates {
+			if predicate.Eq != nil {
+				addIdentifiersIn(*predicate.Eq, identifiers)
+			}
+			if predicate.Exists != nil {
+				addIdentifiersIn(*predicate.Exists, identifiers)
+			}
+			if predicate.Ne != nil {
+				addIdentifiersIn(*predicate.Ne, identifiers)
+			}
+			if predicate.NotExists != nil {
+				addIdentifiersIn(*predicate.NotExists, identifiers)
+			}
+		}
+	}
+	addCreds := func(credsSpec *model.CredsSpec) {
+		if credsSpec != nil {
+			addIdentifiersIn(credsSpec.Username, identifiers)
+			addIdentifiersIn(credsSpec.Password, identifiers)
+		}
+	}
+
+	if callSpec.If != nil {
+		addPredicates(*callSpec.If)
+	}
+	if callSpec.Switch != nil {
+		for _, caseSpec := range callSpec.Switch.Cases {
+			addPredicates(caseSpec.If)
+		}
+	}
+
+	switch {
+	case callSpec.Container != nil:
+		container := callSpec.Container
+		addIdentifiersIn(container.Cmd, identifiers)
+		addIdentifiersIn(container.Dirs, identifiers)
+		addIdentifiersIn(container.Files, identifiers)
+		addIdentifiersIn(container.Sockets, identifiers)
+		addIdentifiersIn(container.WorkDir, identifiers)
+		if container.Name != nil {
+			addIdentifiersIn(*container.Name, identifiers)
+		}
+		if container.Image != nil {
+			addIdentifiersIn(container.Image.Ref, identifiers)
+			addCreds(container.Image.PullCreds)
+		}
+		if envVars, ok := container.EnvVars.(map[string]interface{}); ok {
+			for name, value := range envVars {
+				if value == nil || value == "" {
+					// implicitly bound
+					identifiers[name] = true
+				}
+			}
+		}
+		addIdentifiersIn(container.EnvVars, identifiers)
+	case callSpec.Op != nil:
+		addIdentifiersIn(callSpec.Op.Ref, identifiers)
+		addCreds(callSpec.Op.PullCreds)
+		for name, value := range callSpec.Op.Inputs {
+			if value == nil || value == "" {
+				// implicitly bound
+				identifiers[name] = true
+			}
+		}
+		addIdentifiersIn(callSpec.Op.Inputs, identifiers)
+	case callSpec.ParallelLoop != nil:
+		addIdentifiersIn(callSpec.ParallelLoop.Matrix, identifiers)
+		addIdentifiersIn(callSpec.ParallelLoop.MaxConcurrency, identifiers)
+		addIdentifiersIn(callSpec.ParallelLoop.Range, identifiers)
+	case callSpec.SerialLoop != nil:
+		addIdentifiersIn(callSpec.SerialLoop.Delay, identifiers)
+		addIdentifiersIn(callSpec.SerialLoop.Matrix, identifiers)
+		addIdentifiersIn(callSpec.SerialLoop.MaxIterations, identifiers)
+		addIdentifiersIn(callSpec.SerialLoop.Range, identifiers)
+		addPredicates(callSpec.SerialLoop.Until)
+	}
+}
+
+// addIdentifiersIn adds the root identifier of every scope ref within expression to identifiers
+func addIdentifiersIn(
+	expression interface{},
+	identifiers map[string]bool,
+) {
+	switch expression := expression.(type) {
+	case string:
+		for i := 0; i+1 < len(expression); i++ {
+			if expression[i] == '$' && expression[i+1] == '(' && (i == 0 || expression[i-1] != '\\') {
+				ref := expression[i+2:]
+				if closerIndex := strings.IndexByte(ref, ')'); closerIndex >= 0 {
+					ref = ref[:closerIndex]
+				}
+				identifier, _ := unbracketed.Parse(ref)
+				identifiers[identifier] = true
+			}
+		}
+	case []interface{}:
+		for _, item := range expression {
+			addIdentifiersIn(item, identifiers)
+		}
+	case map[string]interface{}:
+		for _, value := range expression {
+			addIdentifiersIn(value, identifiers)
+		}
+	case map[string]string:
+		for _, value := range expression {
+			addIdentifiersIn(value, identifiers)
+		}
+	}
+}
+
+func sortedKeys(m map[string]string) []string {
+	keys := []string{}
+	for key := range m {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
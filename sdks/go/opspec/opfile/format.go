@@ -0,0 +1,204 @@
+package opfile
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"reflect"
+
+	ghodssyaml "github.com/ghodss/yaml"
+	"gopkg.in/yaml.v3"
+)
+
+// canonical key orders; keys not listed retain their relative order after listed keys
+var (
+	opFileKeyOrder   = []string{"name", "description", "version", "inputs", "outputs", "run", "finally"}
+	paramKeyOrder    = []string{"description"}
+	callSpecKeyOrder = []string{"name", "description", "needs", "if"}
+)
+
+// Format validates and formats an "op.yml" file canonically; comments are preserved.
+//
+// Canonical formatting:
+//   - orders op keys as name, description, version, inputs, outputs, run, finally
+//   - orders param keys w/ description first
+//   - orders call keys as name, description, needs, if, then the call itself
+//   - uses block style collections, 2 space indentation, and only quotes strings when required
+//
+// Formatting never changes the meaning of an op file; if it would, an error is returned.
+func Format(
+	opFileBytes []byte,
+) ([]byte, error) {
+	opFile, err := Unmarshal(opFileBytes)
+	if err != nil {
+		return nil, err
+	}
+
+	document := yaml.Node{}
+	if err := yaml.Unmarshal(opFileBytes, &document); err != nil {
+		return nil, err
+	}
+
+	if len(document.Content) != 1 {
+		return nil, fmt.Errorf("expected a single yaml document")
+	}
+
+	opFileNode := document.Content[0]
+	normalizeStyle(opFileNode)
+	orderKeys(opFileNode, opFileKeyOrder)
+
+	for _, paramsKey := range []string{"inputs", "outputs"} {
+		if params := mappingValue(opFileNode, paramsKey); params != nil {
+			for i := 1; i < len(params.Content); i += 2 {
+				orderKeys(params.Content[i], paramKeyOrder)
+			}
+		}
+	}
+
+	formatCallSpec(mappingValue(opFileNode, "run"))
+	formatCallSpec(mappingValue(opFileNode, "finally"))
+
+	formattedBytes := bytes.Buffer{}
+	encoder := yaml.NewEncoder(&formattedBytes)
+	encoder.SetIndent(2)
+	if err := encoder.Encode(&document); err != nil {
+		return nil, err
+	}
+	if err := encoder.Close(); err != nil {
+		return nil, err
+	}
+
+	// op files are parsed as YAML 1.1 but encoded as YAML 1.2; guard against the two disagreeing
+	formattedOpFile, err := Unmarshal(formattedBytes.Bytes())
+	if err != nil {
+		return nil, fmt.Errorf("unable to format op file: %w", err)
+	}
+	if !reflect.DeepEqual(opFile, formattedOpFile) {
+		return nil, errors.New("unable to format op file: formatting would change its meaning")
+	}
+
+	return formattedBytes.Bytes(), nil
+}
+
+// formatCallSpec orders the keys of callSpec & its descendants
+func formatCallSpec(
+	callSpec *yaml.Node,
+) {
+	if callSpec == nil || callSpec.Kind != yaml.MappingNode {
+		return
+	}
+
+	orderKeys(callSpec, callSpecKeyOrder)
+
+	for _, childCallSpecsKey := range []string{"dag", "parallel", "serial"} {
+		if childCallSpecs := mappingValue(callSpec, childCallSpecsKey); childCallSpecs != nil {
+			for _, childCallSpec := range childCallSpecs.Content {
+				formatCallSpec(childCallSpec)
+			}
+		}
+	}
+
+	for _, loopKey := range []string{"parallelLoop", "serialLoop"} {
+		if loop := mappingValue(callSpec, loopKey); loop != nil {
+			formatCallSpec(mappingValue(loop, "run"))
+		}
+	}
+
+	if switchCall := mappingValue(callSpec, "switch"); switchCall != nil {
+		orderKeys(switchCall, []string{"cases", "default"})
+		if cases := mappingValue(switchCall, "cases"); cases != nil {
+			for _, caseSpec := range cases.Content {
+				orderKeys(caseSpec, []string{"if", "run"})
+				formatCallSpec(mappingValue(caseSpec, "run"))
+			}
+		}
+		formatCallSpec(mappingValue(switchCall, "default"))
+	}
+
+	formatCallSpec(mappingValue(callSpec, "else"))
+	formatCallSpec(mappingValue(callSpec, "finally"))
+}
+
+// mappingValue returns the value of key within mapping or nil if mapping isn't a mapping or doesn't contain key
+func mappingValue(
+	mapping *yaml.Node,
+	key string,
+) *yaml.Node {
+	if mapping == nil || mapping.Kind != yaml.MappingNode {
+		return nil
+	}
+
+	for i := 0; i+1 < len(mapping.Content); i += 2 {
+		if mapping.Content[i].Value == key {
+			return mapping.Content[i+1]
+		}
+	}
+	return nil
+}
+
+// orderKeys stably reorders the key/value pairs of mapping so keys in keyOrder come first, in keyOrder
+func orderKeys(
+	mapping *yaml.Node,
+	keyOrder []string,
+) {
+	if mapping == nil || mapping.Kind != yaml.MappingNode {
+		return
+	}
+
+	orderedContent := make([]*yaml.Node, 0, len(mapping.Content))
+	isOrdered := map[int]bool{}
+	for _, key := range keyOrder {
+		for i := 0; i+1 < len(mapping.Content); i += 2 {
+			if mapping.Content[i].Value == key {
+				orderedContent = append(orderedContent, mapping.Content[i], mapping.Content[i+1])
+				isOrdered[i] = true
+			}
+		}
+	}
+	for i := 0; i+1 < len(mapping.Content); i += 2 {
+		if !isOrdered[i] {
+			orderedContent = append(orderedContent, mapping.Content[i], mapping.Content[i+1])
+		}
+	}
+
+	mapping.Content = orderedContent
+}
+
+// normalizeStyle recursively switches collections to block style & quoted strings to plain style
+// unless op files (parsed as YAML 1.1) would read them as something other than the same string i.e. 'no' or '1.0';
+// the encoder re-quotes scalars YAML 1.2 would otherwise misinterpret.
+// literal & folded scalars are left as is since they're used for readability of multi line strings
+func normalizeStyle(
+	node *yaml.Node,
+) {
+	switch node.Kind {
+	case yaml.MappingNode, yaml.SequenceNode:
+		node.Style &^= yaml.FlowStyle
+	case yaml.ScalarNode:
+		if node.Tag == "!!str" && isPlainString(node.Value) {
+			node.Style &^= yaml.DoubleQuotedStyle | yaml.SingleQuotedStyle
+		}
+	}
+
+	for _, child := range node.Content {
+		normalizeStyle(child)
+	}
+}
+
+// isPlainString returns whether value, unquoted, is read by op file parsing as the same string
+func isPlainString(
+	value string,
+) bool {
+	jsonBytes, err := ghodssyaml.YAMLToJSON([]byte(value))
+	if err != nil {
+		return false
+	}
+
+	var parsed interface{}
+	if err := json.Unmarshal(jsonBytes, &parsed); err != nil {
+		return false
+	}
+
+	return parsed == value
+}
@@ -0,0 +1,85 @@
+package opfile
+
+import (
+	"io/ioutil"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Context("Format", func() {
+	Context("Unmarshal errs", func() {
+		It("should return the expected error", func() {
+			/* arrange */
+			/* act */
+			_, actualError := Format([]byte("&"))
+
+			/* assert */
+			Expect(actualError).To(MatchError("\n-\n  Error(s):\n    - error converting YAML to JSON: yaml: did not find expected alphabetic or numeric character\n-"))
+		})
+	})
+	Context("Unmarshal doesn't err", func() {
+		It("should return expected result", func() {
+			/* arrange */
+			providedBytes, err := ioutil.ReadFile("testdata/format/unformatted.yml")
+			if err != nil {
+				panic(err)
+			}
+
+			expectedBytes, err := ioutil.ReadFile("testdata/format/formatted.yml")
+			if err != nil {
+				panic(err)
+			}
+
+			/* act */
+			actualBytes, actualErr := Format(providedBytes)
+
+			/* assert */
+			Expect(actualErr).To(BeNil())
+			Expect(string(actualBytes)).To(Equal(string(expectedBytes)))
+		})
+		Context("quoted strings YAML 1.1 reads as booleans, nulls, or numbers", func() {
+			It("should keep their quotes", func() {
+				/* arrange */
+				providedBytes, err := ioutil.ReadFile("testdata/format/quotedUnformatted.yml")
+				if err != nil {
+					panic(err)
+				}
+
+				expectedBytes, err := ioutil.ReadFile("testdata/format/quotedFormatted.yml")
+				if err != nil {
+					panic(err)
+				}
+
+				/* act */
+				actualBytes, actualErr := Format(providedBytes)
+
+				/* assert */
+				Expect(actualErr).To(BeNil())
+				Expect(string(actualBytes)).To(Equal(string(expectedBytes)))
+
+				expectedOpFile, err := Unmarshal(providedBytes)
+				if err != nil {
+					panic(err)
+				}
+				Expect(Unmarshal(actualBytes)).To(Equal(expectedOpFile))
+			})
+		})
+		Context("already formatted", func() {
+			It("should return input unchanged", func() {
+				/* arrange */
+				providedBytes, err := ioutil.ReadFile("testdata/format/formatted.yml")
+				if err != nil {
+					panic(err)
+				}
+
+				/* act */
+				actualBytes, actualErr := Format(providedBytes)
+
+				/* assert */
+				Expect(actualErr).To(BeNil())
+				Expect(string(actualBytes)).To(Equal(string(providedBytes)))
+			})
+		})
+	})
+})
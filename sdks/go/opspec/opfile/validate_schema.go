@@ -212,82 +212,99 @@ var _escData = map[string]*_escFile{
 	"/opspec/opfile/jsonschema.json": {
 		name:    "jsonschema.json",
 		local:   "../../../../opspec/opfile/jsonschema.json",
-		size:    48453,
-		modtime: 1616435098,
+		size:    56410,
+		modtime: 1792433402,
 		compressed: `
-H4sIAAAAAAAC/+w9aXMbt5Lf9StQjOtZXIuk5EN5Ucrl8ipKVltxnIqPV/VExQ+aaYqIZoAxgKGkeP3f
-twAM7zmAuSjJ9CeLg6u70Qca3Y0vOwgh1HkkvDGEuHOEOmMpo6PB4C/BaM/82mf8cuBzPJK9/e8H5rfv
-OnumpyQyANXvbeTJALFIROAhFk2/+yA8TiJJGFWtfoIRoSAQpkttRoQS1UR0jpBZkv6AOce3x4wKyTGh
-cvnr8vRrTfdWGt5Guh27+As8ufo14iwCLgmsT2EW4vt6fTg4lRCmN0oD93/fvf0NvdMYQ2crg6AruL1m
-3D/fVSgXR4OBZCwQfQJypFE+lmGQ4P2ak8ux7C0QpTfBAfGxGq+3f/CdAE//97B/sN9dgW62uEccRmpV
-3w0WMD5QmFlE3Frfr+vDdUh5NJAGgf8hE3ZMb98q4M9SP6t/XzK/VEFeDhItJ85HdZ1r1OvccftybrVd
-QnxTgXGmvWvcNPuzTfMij2OmUoNQCZfAs5qFhJIwDjtHaN8OHYRWQUfSuyl0HLSNjpiSzzFUwMjCAE1J
-1WeFSLlgLABMU+TnTg4CFlTL74tKaIQDATspnYymO7mJOAhhkLGiE1cwNW+KrsfEGyOY4CDGEgSSTCli
-PWCGvjxL0YUpzfUHITmhl8vwn6eCkKCqPiDQFPnWUKR3cIQD6gNA/QU1ECEHLhqHF6lMm24UOeKC+EAl
-GRHghbh4jcywSOARoBHjKBaAsLYKF4bJsOCSJa1acFhK4Hr4P896n3Dv79e9f+/3fjh/8qiTut6AsQhf
-BFDjJpwOidRS0a6mEWIcGfR2a6BuHYRSq/wD00sohFe3QmyE5Bg0cHuI9KGv/9T7VYGtfkdssk6vdGMk
-Be2Zq/yIeYrJnycw93Lh+Yg5UXMLhH0ffLV64bEIEKMIsDdGRALXwt/1fECoDzfWumu6jpVJBcJCMI9g
-CT7SI6JrEgToAlCIfUB4gklg9teYs/hy7GbnT5JZ/4ARcKAe2Fn6V3BbK2BXcHsXwDLytk7ADEe0DVqu
-bbFy6E1ltBDzK59d05yz9axJPnu9SZohQtHZZL//9J/omIUho+oDErdU4htjlh0NBiICr+/pz2p4bZqp
-LoMuItQLYl/ph19+foOkwfuNBCpSOHNFK6SCaBSfnR9hvW29joQg0MfQMu4D1bUhm//p00LzNksnWZ5R
-M7b4Orp37M6eaTw9PeOXQa7q2hRynz0E5ALVJ7oSuFU9m0LtfuOonY6TWM8VMDhiPMQyG4eMQjUX1UyI
-5Z/PM809+BwTrozZMSADrtJkyii3Gy/XQs/aUGfFbq3p9Lktz5tyXCWujHJ+K9W5qb1fuPWztmyGS6oC
-oKZzQ4A+rxfQOJAkCqCsppj3b8r/Viu4lMlycFImm9q4LxxvKCz0WBroU3laAnjdtSnwn987c6BeB6ZZ
-R41+l1THVo57JdsRZu9FMea/3ZlivW1Dl5O/57d0uKGcj+TCBWNMfQ7XwoIPDvsv+oeZjFDdFCpyyle/
-l6t6IVjBKtmeIpeRvs5g21Pk3USuDxFQH6hXWkYtjtCUBfZDc4LpPoQRZPnU2osX+MadDfln4g6Ng6Do
-dL3q8rU7Ln+1PRRXtTSWhmiKjZ9tIK6jMmIWh2gKMc/bRsxDOIVa6MHtKbRlcyKqyG1R46x2WIjmzDt9
-98NdVQvDEukm1KKqpFsbpikSfP/wSMCNq94vh/lp76YQ/qK1S5k8C7VxP5bZNXVG4pkR++h0hCLOJsQH
-PwmLMl/2UCKwbhHFIQj0DxN1IGZhB0pv84gFWIJv7xCrI6wowhyHtYbr/K5GBAlcIDZayhhwFEWd5YCw
-Bm/gFvjS4l7LcEHZW629dhZZ6EG7G8v0Cb/rSxyRAO76GvNuue/OKosOt3djlYJ5V3D3V2nh5HG+W8/Q
-7JGl/WQEY5GvykG1FJlHr3WobjRVOFn6pixAGdMWd5j6TDl4WqMfIcnjAtgKrNBZMF/hIF/3bAAa4TiQ
-9sAsW4C1rIGId+BxkC4YXaL+qYlwNvHaRCBhhttzg8hKUdrC5OVcLDoSfC0zsniBOxWWnxl06jDODJct
-ioD/NlNuhUALQmA9KVj1T82BqZHtNr6rXQhfmnC5CyC8TZb6iXDwJONbzbpppvpR5/ldCBbEElCE5Rhx
-xiT4CEvkE448RiUmlNBLxKL+bRggxvcQRhwCLMkk6WO8CxxUxxFnIboeAwetPFmkNafEfN3zUMtFY9vW
-gD/bvC1YBBsXTfps2qJo+JkEsJUKW6lw36SC4pNvQiAkjqAWRcJvJuNgKxTaO4TbePva5rAk8eTBHcMt
-o6/vlhRI+rYoBd7qGbdSoEUpYONNb1sKmDU9PClgGf1yt6RAcpHRohR4p2fcSoHNMaCh+Tdh6ibnjDa3
-tyl/s93e7Sm5u3iYTKogPTglZ+C6i0pux2H0wlGr116JOPhEcVph6Ngxo0b4pEWOKR5FjBtJtBoilR3T
-lBEEZRU+0IHPnfL5HdVmviEia0s1PzuFjc3M5Ikb6MtRe45ZpfDZOrj0vdp/ZIRwECBT3RVzQPA5xkHT
-gaDZEZYeA+4RXXuJTSVt0QVjhjyDlFpl+WIlNafJEM8VqaaslOmMrgeIz4pBtVEmi4L7LqC3811AH8vt
-RshkZFfUUtbmdqiu3uIgOObgp+XG52a8ryo/DroqJA4EigX4yI81FXEsx+p3DxvNSOQ4ObvE3IPEqCYh
-vtTaMSV6uEAExgI4xaE9C1TYdSV2XHpuhhDXjPt3dcm5eypXD86pkQd2rspzjvAXEH60qGd6Nnna3+/v
-IwEhVtsRTYAr+OfF7iCcANcpFSICb2Da98cyDLrly5zunulw9u5w2E/57+6ro93hsKf+et37N+793Tt/
-svvqaDjsL/3U/a9u95X+/cnC78Nhbzjsnz/pvsqonrpu4meXv1hvuy2p12CCneXpa1sMYePI/dZL6jlm
-b5Uoqbfqd4sj4AKkMg2W8GnGaQSj3zdY5GYqYX0soSdJCI4V/5YLa0wHQQYb9WKh/yy7sJmd7stiH5vk
-oBl+Wk/GmNOIeVfAe9oc7SnZ4pihgMwAiT07M/sRFkgLKPDRxS06uyRyHF/0PRYOTIeBTxQ6L2I10mDW
-b07dgh6SA0w/HPQPns2H2Bw5V1G5OapCiElQhev0AE1x3NONkcjgZXN0GTMhMw4L1qSZjtEUdZ5tjDoz
-7GyOQCSaPK9CHNW/KcI83xhhNFY2SpTDikQ5bIooLzZJlMMNEiXmpApNYk6aIsnhxkiicLI5ihh3jrMB
-t+oeWjXd5l6iNMfRxnCdQLuZ4tu/Ar2U49I1xkz3hk7Kh/VV0TqwLS9WCR/T7g3h4/saq4rt7RSEf3w7
-dccsXE7bumMte+7mbvjyha8aQvA/C/GbqyDmLrUOh0u4afw5Q7OaGquBp1+c3Ken9FYLhWVfray2tCyo
-hLzsPtkP5X6pVtTDKfs5vwLw7FutvHPo7qEsFW86pV4FFOgh7ifwZowqsN9GULdSsobduqrXXuOVd3KQ
-fM2JhLc0uK2G6dkwNdf+P9h38H0VV/Avr8e/uNhhxXVUqs5Q7p2TL80Xsf1St71qoQTXI5QKnyud3Ykk
-L+qaAcoHWAyHj4bD3bPep/6seuCj3e7ZcDgYDs/PnwyH3cXoiJ0FCLKUYic1nGgtExaHs2dX16OWbF48
-zNO16ynhsz+zJ7WN7V96EZhGcZr1kj5WUj8yvdJmLOsaise0zhqVr5Eg9DIARJk/o9mZh4MAXXIcjeeS
-E2j/mlyRCHyCtexUfw2OcRB80i27LQVuJzn9WR6d5uOYWbSpmdW+CAIINj3/r2xzOBDACQ42O7sT/JWC
-2OebPfOkblcD2vaQ44W+s811zMIQUx/xmKKLW4TRbNU/6oe1OfFB6LBqARJhqWWMCQMIYAKBrSVlY5i6
-PBNSb8h1gUwvCr3OtwZRcWGsYqDttkqmmk3qCREQiFBNw/nuLEr7s664vtTtz90zY7icH3VfKTNmOBws
-PYxvnedVHKrkdpdShKzdaT3rCxZT81C7somScjOIRV3LXLk12um3U6y7ft1rCeAs5vEJT0L+/zFgPHmu
-nsNIowQkiiNGEdwQ6YqPalxmx3F2B1m7cYpSAK38ji4iAejkI7aRCva8Ycls5fOMKwqMueA4+/Oli3yo
-ICfKsY+93CB0gWmuB0aOqFOYqwSpKElKSJR6UVOTenZ2BJyUECluosVexLiN+3VvY3VUKqVfW+R2uSHk
-3M3SOaETwhkNgcqZEybF5ildp7BpS+1nEmxttK2NZi1BdU3AFo208nL1gZpq+ixct1BwLbViSFWyHshK
-TL9kiINgwQRMQU0K8prxq36nUiUWF+PepmJIdnqv48LmA9WyLsG90pR4N0sd1puqj958ePdeCTmM9F0W
-Opsc9Pf7B+jt8SnafRsBRcdTDYFOFUC6MGoX/cdkJwT4lsXyP6nZFiwCOlMvYmA66PzQi4BdDMxEg8Vx
-+qHfnRcm7jdWULUSe7s9P1KcvtG2NMnN+M69slmyF5CHqdo4M67WQZ6anZkcA5+3FEWiqKoayIM2Ytyi
-4M9aJInqlSh+DdIy6JLpH8ZMyM5erUK5nJFlUqJ3e0lq9Ktd6UX/F/tR91VpQfE/TEikkLcrugreC6Jt
-IEeWtLfV7MJ6lzeyZXVXCy5Lux5dRWrnvpkNpsRd7aeJsnv0yOaJuuK7hCkPJgX8sO8r+YBCHEXgJxFy
-5lNxEuKGNEjtlFbG0082b1+sYPNfjF8RernwFoA+3ewu38osxPBrZV1fHJVTuTK7GzhjKzu92uVEjvRH
-/4sj/5yrB6ZNROwDnk9NoaJZBbblKFLjazVaDQfB7HlNcUUUG/U3E8I8LxdXpfqQS0GbxUgUL+YcqNQI
-6aNjY93ocjySIaIL9Ixu5yjTZsGH08cCMW0QBERIhAWiAL5hlsQMwkEg+pYxy5Y1q/LOJGsRHDR5+IuN
-kCAXgeJ3DYB5YdWEGK/Cr6EQffRuocP8EdYrEgTgI6aOcpShgNFL4AngG9o6CX1Ilj1gt3dY1NY9ekbs
-UCFX6xNYQP4GgU5/+/3D+0+/vX5zYvbix9e/fjhBhCbZcOjxvMGR+fhYv7ybtBOIxkGwh4icnwGFiEPw
-kxYvX6JHu/MxunfD0l0MVnOxIxr3Ht415972ArSo9n9G0J0LD8657+2H9zN2XOBBw30LHw0PLrXO4UTd
-4OXLxfb3mw2zsy8eKBuWLDadZSA49l6M9l1+JPzR3ZVRJe73HriosvdEl/dA581v4/vPjFYX05eyJEM8
-posu58eXRPY4ROy7L+9O3nw8+ePTL6fvP71//cvXgTqIPlam9ePpdph7Gh8juxzwTZ5DM/2+jZ1CZ9G/
-hSZsfSb43Nwd8JhWyzhdiB3OhMA9dGh1W6rxlYI2556kojPXKTymrLM66oCPBAnjQGIKLBZB5lWEtb3P
-MbW5wkvn3oCx6A89QDnujd0TEv81xhJdghQ6apdRBNgbz1E1PTOrldl79212SxEwE6uYtWw86pi3diWB
-plwBfZxERWHh/rTsXBMXfy9lw0JUfWuSYWW/a1aYigb4HJtyzTmCoXLxQ8drxoJNVrzZio2j8pVl3GCJ
-qSysOVcZFieG20r5lqW82QKu4JzcEIm82UsiC6v/ceb+9tEFjBiHFVD7m0oyKeUJLz6m3BcV6vgGTXom
-rSk3VZgI+jEpS+WYBJoUSU9P3IxEBJ7LzGemyzxgxvzdJ6xrLhsubqutbilNN1XwdualEROBl3/4+Pr/
-AQAA//8+ZZlbRb0AAA==
+H4sIAAAAAAAC/+x963IbN7Lwfz1FF+Nai58pUo5jZ1eulMuf4uT4VBylYsdbtZLWBjlNEesZYAxgJDG2
+H+u8wHmyUwBmyCE5F8yNlBzlTywObt3oGxrdjU97AAC9e3Iyw4D0jqA3Uyo8Go3+Izk7sL8OubgYeYJM
+1cHh9yP72ze9ge2pqPJR9zsJJ8oHHsoQJ8DD5LuHciJoqChnutWPOKUMJRC20mZKGdVNZO8I7JIAAHpE
+CDI/5kwqQShTq19Xp99oOlhrOA9NOz7+D07U+tdQ8BCForg5hV2I55n1Ef+lwiC7URa4//365Fd4bTAG
+p2uDwAecX3Hhne9rlMuj0Uhx7sshRTU1KJ+pwI/xfiXoxUwdpDbl4JL41CN6vIPDh99InJh/Phk+POyv
+QZf817sncKpX9c0ohfGRxkwacRt9v2wO16P10UA7BP4fubATNj/RwJ9mfgYA+JT7pQnyCpDoOHExqttc
+IwDAl71qX86dyCUg1w0YJ+ndItEcLojmcRHHJFKDMoUXKPKaBZTRIAp6R3Dohg7KmqCDsk7R8XDb6IgY
+/RhhA4ykBuhKqj4qRcqYcx8Jy5CfewUISKmW39JKaEp8iXsZnayme3EdCpTSIuPTXhGmlk3hakYnM8BL
+4kdEoQTFgTAwA+boy9MMXZjRHACgJ5Wg7KK3ly0e0iDEqGoPCEiQ7wxFdoeKcGB7AOi/sIVNKICLRcE4
+k2mzjaKKuKAeMkWnFEUpLp6DHRYkmSJMuYBIIhBjFaaGybHg4iWtfQ2JUijM8P8+PXhHDv58fvCvw4N/
+nD+418tcr895SMY+tkiEyZCglwr7Zo+AC7Do7bewu21slF7lMfd9PVAZxCeRCiMl4YKoGQr0YCp4AHiJ
+Yg5UoTCS8ikgmcyASsDrkEv0QHFQM4SQCGQK5ISHyfYayICyZW/gwkMBXAyATk03QdgFArWHBAPwYPlP
+Ld/Rg/HcYFv/lUcnmWIt35jKMZ4uiaB6T3/HKQpkE+ztZcv2dRS/IkrQ61IM22bALei6I+xTBqFPJqh/
+NcjoD4AOcZjCAp9aVEq4mnGJMOHBmDKDUAlE2JHQA365yUicYY4p/KnKuaEEMznKPmeKHC2Y3drRoC+3
+rTPopdg4HjSdrDYq3Wz0870Kyy479abU3MSPPCxslEXbx2miVBzicWJ5Ef8lV2k3IGoy07qB+D5QJSG1
+yMFehY0ctHaqWnMd1D0+5S+oR1k7CI7HAcogYahEFq8gOZY2gRVRtxutlSi+bM4vjvrzdy2VS2W7aZUW
+7bEY138aew8UN79niulsUZFhtuSu8i0Rm3tReOAYFMLzNhZTUlOXVfNWt3MWmwCJUq/qX6PMw2vns1+y
+jrVJJRAp+YQShR6YEeGK+j6MNaV7COSSUN/0UzPBo4tZNT9ZPX3X0xZKm4B9wPlNAMueV9oEzIy4ddAK
+z+Z54iLVrhcQ8cHjV6zAN71oMigxBW0zoAxOLw+H3/4djnkQcKY/gJwzRa6tW+NoNNJu9uHEfNbDG9eG
+7jLqx1pA69Cff3oFyuL9WiGTGZy5dqrKBNEeHN388Jtt23XE+/7JNPMTlLrfddeOfGbfflvqHipSnw5q
+M4fEN9G956Yls3g6ManrIJfNu0Puo68BuciiIHcRhbjVPbtC7WHnqE3GschsgsEpFwFR+TjMP9cm/5VY
+1wshVuzfzjX38GNEBUpj31lwYYzGqeU2XqGHK4+gTsst7WT6wpbnXV38xFcB9e59aNAd7ZeSfh7J5lzp
+NACUsg4B/a5dQCNf0dDHuppi2b+r+6tWwWVc1YOTcdUV4T6ueMPvoMeyQE/kaQ3gTdeuwP/u1pkD7V4A
+2nW0eG+ReTFUcD2Rf5HkfgthzX+3M8Vm246Cexx8z44RPsuRqnDBjDBP4JV04IMnw8fDJ7mM0NwUKrvU
+LhAcjlM0DahpYJXcnSJXkb7JYHenyJuJXA9DZB6ySW0ZlR6hKwvsH90JptsQhpfnU3MXY00F3F/c2VB8
+Ju6xyPfLTtdlt1LnDZg4INdNLY2VIbpi40c7iItsjBjKukfMd9tGzNdwCnXQg3en0C2bE2FDbgs7Z7Un
+pWjOjYmDeoFFDSwMR6TbUMWmkm5jmK624PuvbwuEddV79TCf9O4K4Y+3dilTZKF27seyVNNmJLsdcQgv
+pxAKfkk99OK4U/tlkESTzYGRACX8zUYdyEXYgdbbIuS+jkhwd4i1EZYbEkGCVsN1ftMjokJhAr7SGXcV
+RVFvNaC6wxu4FF863Gs5RGyed3ASrLbIUg/azVimR8VNX+KU+njT11h0y31zVukScrn7VUo++YA3f5UO
+Tp7Kd+sNA7atYDwqA9RdtWyKi7WMHj0hhInCydM3dQHKmba8Q9wpFDgxGv0IlIhKYCuxQhfBfKWDfBm4
+ADQlka/cgamQreC6Bipf40SgqoLRld1/aSOczZKASpB2uEE1iJwUpStMk4KLxYobvlFZoHyBTZJInOPi
+C8ZZ4HKLIuD/2ynvhMAWhMBmUQ3dPzOHtEW22zlVV9n42htXuAAqtslSP1KBE8XFnWbdNVM9NbmrY8n9
+SCGERM1AcK7QA6LAowImnClCmQ665+FwHvjAxQAICPSJopdxH+tdEKiSTNornVVrlCcPjeZURGx6HkqZ
+ysUGhS1bA96CeLdgEexcNJmz6RZFw0/UxzupcCcVbptU0HzylxAIsSNoiyLhVzPjnVDYglBwyWnZFYfZ
+NX19x3DH6OubJQXivluUAidmxjspsEUpUEYgu5ACdk1fnxRwjH65WVIgvsjYohR4bWa8kwK7Y0C7538J
+Uzc+Z2yTvM2Md+S9RSV3Ew+Tdk1fn5KzcN1EJddhsaU6tVdCgR7VnFYaOnbMmRU+WZFjmkeBCyuJGlfL
+cwof6OHHXv38jmYzX1Op5K5mZ7izmbl6UQ30vYKYjNKsUvzoHFz6RtMfncYl7zCwFRzxY0T8rgNB8yMs
+JxzFhJraSzyRtGUXjDnyDDNqlRWLlcycJrt5VZFq1gy2M1yNQCyKQW2jTBbD6lTA5ksqYPfVHSHkMnJV
+1DK+TXJort4i3z8W6GXlxhdmvK8rP4GmqjLxJUQSPfAis4skUjP9+4RYzUjVLD67RMJWnyUMaEAujHbM
+iB4uEYGRRKHjrJ33qQHV1aC47NwMKXVI/01dciFNFerB5W4UgV2o8ipH+EsM3jrUAz+9/HZ4ODwEiQHR
+5AiXKDT8y2J3GFyiMCkVuu7dyLYf6vSKfv0y4funJpy9f3Y2zPjn/rOj/bOzA/3X84N/kYM/D84f7D87
+OjsbrvzU/3/9/jPz+4PU72dnB2dnw/MH/Wc51cc3Tfz88hebbe9K6nWYYOd4+rorhrBz5P7VS+pVzN6q
+UVJv3e8WhSgkKuBTWMGnHacTjH7fYZGbRMJ6ROGBogFWrPi3goPFIGCx0S4Who/yC5u56b489nFJDlrg
+Z+vJGMs90g51cWDM0QMtWypmKIAdILZnF2Y/EAlGQNlHJU4vqJpFY11Zd2Q7jDyq0TmO9EijRb/l7pb0
+UAIx+fBw+PDRcojdbec6Kne3qxgQ6jfhOjNAVxz37c62yOJld/sy41LlHBactyYZo6vdebSz3VlgZ3cb
+RMPL75psju7f1cZ8t7ONMVjZ6aY8abgpT7ralMe73JQnO9yUSNAmexIJ2tWWPNnZlmic7G5HrDunsgG3
+7h5aN92WXqIsx9HOcB1Du5vi278gu1Cz2jXGbPeOTspP2qui9dC1vFgjfFDWKT6+b7Gq2GCvJPzjr1N3
+zMHldFd3rBU0QtUSWI0KX3WE4L+X4rdQQSxdaj2BF3jd+XPAdjUtVgPPBO9WPUW7Xigs/2plvaVjQSWY
+5PfJf5fyU7OiHpWyn4srAC++tco7T6p7KGvFmya71wAFZojbCfw8bAr7PMS2lZIz7M5VvQadV94pQPKV
+oApPmD9vhunFMC3X/n94WMH3VV7Bv74er/RecHkdlaYz1Hvn5FP3RWw/tW2vOijBzQil0udKk5bJi/R2
+gPoBFmdn987O9k8P3g0X1QPv7fdPz85GZ2fn5w/Ozvrp6Ii9FAR5SrGXGU60kQlLgsWzqzwsWX8m8op0
+7WZK+OLP/EldY/vTi5hSRnx/XrqAY+L76AGZKhQgIgYCL4jwfJRSL4hHasIDHABeIkveWLeJ5R+o7jmE
+93Gb9/A3eI9CcPEeiECgzD7wmg/Ncp9GIsqBgzL9hvwmGNk4ietgZo7EI9XWUHq1LdbafA6SsgsfgXFv
+QXunEx3ReyFIOFtqAGTDK/qBhuhRYnSA/muk9/CdadnfUgB6XJsgzzPVfTy2Ry52NTUPdzWzJknfR3/X
+8//Cd4cDiYISf7ez7xT+K6oms+1kISy5/Chv0W5FvF1PqZPAq/OMfUCYZ3TXeA4EFqt+al5GF9RDaeLi
+JSogyghXG8fh4yX6u3rBvt2Y+QZhwfn0UkLHS1CoKAfajVTKqplRlECZ2cMldZYM5V4yP/2fDvG1luf5
+Uf+ZtkPPzkapQtb33BP1ymPN3ESEK7L2k4LkYx4x+9I+CRb1goCHfQdKytw78/iNc9cvgy0BnMc8HhWW
+c+BvIy6sOQoCpwYlqCAKOdPpHKoqPppxmRvHFUv2auOU7ISb47jCoD1kl2+Ji1Rw5w1HZqufKN5QYCwF
+x+m/f6giHxrIiXrs4y43KEsxzdXIyhF9jK4qQRpKkhoSpV3UtKSeKwiTjauZ6siq1OO8ZZH1ZbCzQjiN
+8ucdkvOqIeS8mqXzgl1SwVmATC28aBk2T+1Ck11baj/RzPXe2Wh3NlqmBNU0uU0jrb5c/UpNNXMWblso
+VK2VY7eqZkGXtaQMxUGg5P4l2oqoDNUVFx+GvcHelox7B6YqyM+uuLDlQK2sS4pJ7Z14vcj9NkQ1hFd/
+vH4DYzQXQj714PTy4fBw+BBOjl/C/kmIDI4TDQEvNUCmsm0f3pv+Bz6Z80i9z0yX4SGyhXqRI9vBJPiO
+fT4e2YlG6XGGgddfVpYedlYRtxF7V3s/pjz/ZtvSpDBlv/DObcVegAlhME5xtYnSNezM1QzFsqUsE0VN
+1UARtCEXSlYG9zfdK1b8BqRV0BU3P+g8kd6gVaFcz8iyOe37B/b//Wf7ahJ+jryw/6y2oPgvLhVo5O3L
+PigOY2psoIos6W6rucVlrxKyY3leBy5b3wBTtmANqb3bZjbYGoWtnybq0uiRyxuDZWS5VEYWOiCep+UD
+BCQM0YtDHO2n8izSHWmQ1ndaG08/ujxesobNf3LxQTtHvNRLJGoG+6u3MqkkDKOs2wuEq1Rvzu32zayw
+V+nZtUrb8WWQfQtHWYQn7IUOrnAOe345tfUjYUqoH4lFYIGJK6ASBE648DTmIwUymkw0oZvftWC21K7b
+h7qSlDLdhmXRzbnxYlmAucSkVq5rmTUR6sp8rmiLA2IokwqJt0BaJBZYSOpsLQoI6kbv6fT9ajC02eFh
+ebpBXhRMEUR6S38i0j2/4nkY+tSuK7nFN7BI896tdY6DpGNfM6z5kBQQs2E++lkJBpyhoSb5dElJ5m+4
+GplfTPiPfTE2/oRePBw3+fK+D8i8IcRPYSwqKLZLWXnBTw7YsTf8CW5WoqM0gOnPZaFSNQOkWiIROq0i
+KVbpeZOOB8sNTxxX8gPVSnG4m4ySxWIbFYOrUl8sHRiYFgeaSsxZxVRHUxyoqZc2nad4RBv5f7y8L4EL
+3cKnUgGRwBA9q/riQ42hOscUEjcIscjDsMEHLH6HkU9XhEH84LXN+FiH30Ahh/B6RXokVGKZwHI/4+Bz
+doEiBnxHpBPvD0XRhHZ4uK2omJwQyFKuNv4Un/6JEl7++tsfb979+vzVC0uLb5//8scLoCxOTob7ywZH
+9uN9oxjidhK0U3kAVC09OlJGAXpxix9+gHv7yzH6N+Pcmo4dPn9wg+4Cbpqr/i6coewplpzY4So8uOS+
+kz/eLNgxxYOW+1IfLQ+utC7gRNPghx/S7W83G+Ynw32lbFiz9n+egVCxdzr54vTg3co16o2VUTVu679y
+UeV+r1T/PqlofpebvNzkIZnklyiuA3rTF0j3L6g60L6Jbz69fvHq7Yvf3/388s27N89//jLSbqX7wAXc
+T8hheW9wH5y4YadepdxbnM58Sjp9oopDJLH9zWOS1phPDjdmy+bGoof9S0rgvf6nfN+3PiX0nkKsPYFP
+kxPPwsOgPawLVxMVxQfi9o8FZQdrqFCUIM7NKD0U3OTVm8yGXAiqh1au05IeH2hSOyB+skCYHFVLDhPr
+cJE0iHxFGPJI+vNh0xPUhPu+01N62RLR5zw8joeoF3dV5q7Lw9fSN7eKp5RjjguQipvrCWNcGhbV5/As
+j52GxMFjl5qsntvO3X3ngr6AKEGvm+zeKztCzdmvjzmzHo9J9aTuV+Ra19VJntbk0zR2M8n9qd26iElU
+A2cmqXcNblfV9BpcEOYSQpS/P7+bAerNHVWvaPHPGVFwgUpqIwM4AyST2RLLiZdPr8wdrS7SuAyYS6eY
++Xw8mpj7lmyXxhWSK4ayFJMAdFRXrtoigxI5AnXKsTWzHfNI7bzuw1qDvZy0x9tp2qRSNrdm2KyJEyNp
+EqGNHyP7nEqBXbMT1nOIDSyUazeDQyOmSmtC3xZYYmnTMTCVpMdtsbg99El1W+1H3QvGqK4Q2YrVJYGA
+F9k/Y4+CPt/O9Jf7j+X9MjOh9TS3Wr6+rBds9uNHaUxkH5OfI/n5f/9Hfg7kZ/k5+Dzr913cf7cvKed2
+HkReLkiy1XPIxuGQ6q/3JTCuwOMMFxEQVMfCsXmq79254ys4d1itWRWcF9dUwWTxOGpq9U8XISQejHHK
+Ba6BOtxV2YVa0STlMuW2HOpqWf+25EiH1vNx4keGKRVSwYRIhKsZl7gMSpLWCxWTVeKAGtjImSsqrUc6
+rlHc3GNIJFaXsce6V4r0KQMdYil2RezNcuGrJnFBSdiZCwZ/W263PUoFkVRm58doNhymXMR3D9JQwTjx
+h1UJ7q+C8JrIb0/quEkgR2lUWYe56zM6XW4MlTV2pbpOq4aaLy4nqSontITiK6C7tKVDLkmlnPmaJX0c
+n/ovpQjGqxFEHcOmy4fls8tj2jckSosrvrXtqlZ2jF8+zZyahzrHscrMp7bLMonS/j2kvG9DVsfzZqtb
+qb2ZyT+95XtHMXkXX2F/+b8BACwQknpa3AAA
 `,
 	},
 }
@@ -1,16 +1,15 @@
 package opspec
 
 import (
-	"fmt"
-	"strings"
+	"github.com/opctl/opctl/sdks/go/opspec/internal/varref"
 )
 
 // RefToName converts a variable reference to the name of the variable
 func RefToName(ref string) string {
-	return strings.TrimSuffix(strings.TrimPrefix(ref, "$("), ")")
+	return varref.ToName(ref)
 }
 
 // NameToRef converts a variable name to the reference form in an opspec
 func NameToRef(name string) string {
-	return fmt.Sprintf("$(%s)", name)
+	return varref.FromName(name)
 }
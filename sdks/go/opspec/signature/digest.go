@@ -0,0 +1,86 @@
+// Package signature signs ops & verifies their signatures
+package signature
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+)
+
+// FileName is the name of the file, within a signed dir, holding its detached signature
+const FileName = "op.sig"
+
+// Digest returns the content digest of the dir at 'path' in the form sha256:HEX.
+//
+// Digests are computed over the path, executability & sha256 of each file (& the target of each symlink)
+// so are independent of timestamps, owners & how the dir was sourced (i.e. git clone, tarball).
+// '.git' dirs & the signature of the dir itself are excluded; empty dirs don't affect digests.
+func Digest(
+	path string,
+) (string, error) {
+	hash := sha256.New()
+
+	err := filepath.Walk(
+		path,
+		func(contentPath string, fileInfo os.FileInfo, err error) error {
+			if err != nil {
+				return err
+			}
+
+			relPath, err := filepath.Rel(path, contentPath)
+			if err != nil {
+				return err
+			}
+			relPath = filepath.ToSlash(relPath)
+
+			switch {
+			case fileInfo.IsDir() && fileInfo.Name() == ".git":
+				return filepath.SkipDir
+			case fileInfo.IsDir(), relPath == FileName:
+				return nil
+			case fileInfo.Mode()&os.ModeSymlink != 0:
+				target, err := os.Readlink(contentPath)
+				if err != nil {
+					return err
+				}
+				_, err = fmt.Fprintf(hash, "symlink %q %q\n", relPath, filepath.ToSlash(target))
+				return err
+			case fileInfo.Mode().IsRegular():
+				fileDigest, err := digestFile(contentPath)
+				if err != nil {
+					return err
+				}
+				// only executability survives git so other mode bits are ignored
+				_, err = fmt.Fprintf(hash, "file %q %v %v\n", relPath, fileInfo.Mode()&0111 != 0, fileDigest)
+				return err
+			default:
+				return fmt.Errorf("unable to digest '%v'; only dirs, regular files & symlinks are supported", contentPath)
+			}
+		},
+	)
+	if err != nil {
+		return "", err
+	}
+
+	return "sha256:" + hex.EncodeToString(hash.Sum(nil)), nil
+}
+
+func digestFile(
+	path string,
+) (string, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return "", err
+	}
+	defer file.Close()
+
+	hash := sha256.New()
+	if _, err := io.Copy(hash, file); err != nil {
+		return "", err
+	}
+
+	return hex.EncodeToString(hash.Sum(nil)), nil
+}
@@ -0,0 +1,85 @@
+package signature
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"time"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+// newTestOp creates an op dir containing 'opFile' & returns its path
+func newTestOp(
+	opFile string,
+) string {
+	opPath, err := ioutil.TempDir("", "")
+	if err != nil {
+		panic(err)
+	}
+
+	if err := ioutil.WriteFile(filepath.Join(opPath, "op.yml"), []byte(opFile), 0644); err != nil {
+		panic(err)
+	}
+
+	return opPath
+}
+
+var _ = Context("Digest", func() {
+	It("should ignore timestamps, '.git' dirs, empty dirs & the dir's signature", func() {
+		/* arrange */
+		opPath := newTestOp("name: test")
+
+		expectedDigest, err := Digest(opPath)
+		if err != nil {
+			panic(err)
+		}
+
+		if err := os.Chtimes(filepath.Join(opPath, "op.yml"), time.Now(), time.Unix(0, 0)); err != nil {
+			panic(err)
+		}
+		for _, dirPath := range []string{".git", "empty"} {
+			if err := os.MkdirAll(filepath.Join(opPath, dirPath), 0777); err != nil {
+				panic(err)
+			}
+		}
+		if err := ioutil.WriteFile(filepath.Join(opPath, ".git", "HEAD"), []byte("ref"), 0644); err != nil {
+			panic(err)
+		}
+		if err := ioutil.WriteFile(filepath.Join(opPath, FileName), []byte("{}"), 0644); err != nil {
+			panic(err)
+		}
+
+		/* act */
+		actualDigest, actualErr := Digest(opPath)
+
+		/* assert */
+		Expect(actualErr).To(BeNil())
+		Expect(actualDigest).To(Equal(expectedDigest))
+	})
+	It("should change w/ content, paths & executability", func() {
+		/* arrange */
+		opPath := newTestOp("name: test")
+		digests := map[string]bool{}
+
+		for _, modify := range []func() error{
+			func() error { return nil },
+			func() error { return ioutil.WriteFile(filepath.Join(opPath, "op.yml"), []byte("name: changed"), 0644) },
+			func() error { return os.Chmod(filepath.Join(opPath, "op.yml"), 0755) },
+			func() error { return os.Rename(filepath.Join(opPath, "op.yml"), filepath.Join(opPath, "op.yaml")) },
+		} {
+			if err := modify(); err != nil {
+				panic(err)
+			}
+
+			/* act */
+			actualDigest, actualErr := Digest(opPath)
+
+			/* assert */
+			Expect(actualErr).To(BeNil())
+			Expect(digests).NotTo(HaveKey(actualDigest))
+			digests[actualDigest] = true
+		}
+	})
+})
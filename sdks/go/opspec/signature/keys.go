@@ -0,0 +1,101 @@
+package signature
+
+import (
+	"crypto/ed25519"
+	"crypto/x509"
+	"encoding/pem"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+)
+
+// LoadPrivateKey loads the PEM encoded (PKCS #8) ed25519 private key at 'path'
+// i.e. as generated by `openssl genpkey -algorithm ed25519`
+func LoadPrivateKey(
+	path string,
+) (ed25519.PrivateKey, error) {
+	keyBytes, err := readPEM(path, "PRIVATE KEY")
+	if err != nil {
+		return nil, err
+	}
+
+	key, err := x509.ParsePKCS8PrivateKey(keyBytes)
+	if err != nil {
+		return nil, fmt.Errorf("invalid private key '%v': %w", path, err)
+	}
+
+	privateKey, ok := key.(ed25519.PrivateKey)
+	if !ok {
+		return nil, fmt.Errorf("invalid private key '%v': not an ed25519 key", path)
+	}
+
+	return privateKey, nil
+}
+
+// LoadPublicKey loads the PEM encoded (PKIX) ed25519 public key at 'path'
+// i.e. as generated by `openssl pkey -pubout`
+func LoadPublicKey(
+	path string,
+) (ed25519.PublicKey, error) {
+	keyBytes, err := readPEM(path, "PUBLIC KEY")
+	if err != nil {
+		return nil, err
+	}
+
+	key, err := x509.ParsePKIXPublicKey(keyBytes)
+	if err != nil {
+		return nil, fmt.Errorf("invalid public key '%v': %w", path, err)
+	}
+
+	publicKey, ok := key.(ed25519.PublicKey)
+	if !ok {
+		return nil, fmt.Errorf("invalid public key '%v': not an ed25519 key", path)
+	}
+
+	return publicKey, nil
+}
+
+// LoadTrustedKeys loads each public key in the dir at 'path'; none if it doesn't exist
+func LoadTrustedKeys(
+	path string,
+) ([]ed25519.PublicKey, error) {
+	keyFileInfos, err := ioutil.ReadDir(path)
+	if os.IsNotExist(err) {
+		return nil, nil
+	} else if err != nil {
+		return nil, err
+	}
+
+	trustedKeys := []ed25519.PublicKey{}
+	for _, keyFileInfo := range keyFileInfos {
+		if keyFileInfo.IsDir() {
+			continue
+		}
+
+		publicKey, err := LoadPublicKey(filepath.Join(path, keyFileInfo.Name()))
+		if err != nil {
+			return nil, err
+		}
+		trustedKeys = append(trustedKeys, publicKey)
+	}
+
+	return trustedKeys, nil
+}
+
+func readPEM(
+	path string,
+	blockType string,
+) ([]byte, error) {
+	pemBytes, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	block, _ := pem.Decode(pemBytes)
+	if block == nil || block.Type != blockType {
+		return nil, fmt.Errorf("invalid key '%v': expected a PEM encoded %v", path, blockType)
+	}
+
+	return block.Bytes, nil
+}
@@ -0,0 +1,85 @@
+package signature
+
+import (
+	"crypto/x509"
+	"encoding/pem"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Context("keys", func() {
+	Context("LoadPrivateKey", func() {
+		It("should load PKCS #8 ed25519 keys", func() {
+			/* arrange */
+			_, expectedKey := newTestKeyPair("")
+			keyBytes, err := x509.MarshalPKCS8PrivateKey(expectedKey)
+			if err != nil {
+				panic(err)
+			}
+
+			keyFile, err := ioutil.TempFile("", "")
+			if err != nil {
+				panic(err)
+			}
+			if err := pem.Encode(keyFile, &pem.Block{Type: "PRIVATE KEY", Bytes: keyBytes}); err != nil {
+				panic(err)
+			}
+			keyFile.Close()
+
+			/* act */
+			actualKey, actualErr := LoadPrivateKey(keyFile.Name())
+
+			/* assert */
+			Expect(actualErr).To(BeNil())
+			Expect(actualKey).To(Equal(expectedKey))
+		})
+		Context("key isn't PEM encoded", func() {
+			It("should return expected error", func() {
+				/* arrange */
+				keyFile, err := ioutil.TempFile("", "")
+				if err != nil {
+					panic(err)
+				}
+				keyFile.Close()
+
+				/* act */
+				_, actualErr := LoadPrivateKey(keyFile.Name())
+
+				/* assert */
+				Expect(actualErr).To(MatchError("invalid key '" + keyFile.Name() + "': expected a PEM encoded PRIVATE KEY"))
+			})
+		})
+	})
+	Context("LoadTrustedKeys", func() {
+		It("should load each key", func() {
+			/* arrange */
+			trustedKeysPath, err := ioutil.TempDir("", "")
+			if err != nil {
+				panic(err)
+			}
+			firstKey, _ := newTestKeyPair(trustedKeysPath)
+			secondKey, _ := newTestKeyPair(trustedKeysPath)
+
+			/* act */
+			actualKeys, actualErr := LoadTrustedKeys(trustedKeysPath)
+
+			/* assert */
+			Expect(actualErr).To(BeNil())
+			Expect(actualKeys).To(ConsistOf(firstKey, secondKey))
+		})
+		Context("dir doesn't exist", func() {
+			It("should return no keys", func() {
+				/* act */
+				actualKeys, actualErr := LoadTrustedKeys(filepath.Join(os.TempDir(), "does", "not", "exist"))
+
+				/* assert */
+				Expect(actualErr).To(BeNil())
+				Expect(actualKeys).To(BeEmpty())
+			})
+		})
+	})
+})
@@ -0,0 +1,42 @@
+package signature
+
+import (
+	"crypto/ed25519"
+	"encoding/json"
+	"io/ioutil"
+	"path/filepath"
+)
+
+// signature is the detached signature of a dir
+type signature struct {
+	// Digest is the content digest of the dir when signed
+	Digest string `json:"digest"`
+	// Signature is the ed25519 signature of Digest
+	Signature []byte `json:"signature"`
+}
+
+// Sign signs the content digest of the dir at 'path' w/ 'privateKey' & writes the signature to FileName within it.
+// Any existing signature is replaced.
+func Sign(
+	path string,
+	privateKey ed25519.PrivateKey,
+) (string, error) {
+	digest, err := Digest(path)
+	if err != nil {
+		return "", err
+	}
+
+	signatureBytes, err := json.MarshalIndent(
+		signature{
+			Digest:    digest,
+			Signature: ed25519.Sign(privateKey, []byte(digest)),
+		},
+		"",
+		"  ",
+	)
+	if err != nil {
+		return "", err
+	}
+
+	return digest, ioutil.WriteFile(filepath.Join(path, FileName), signatureBytes, 0644)
+}
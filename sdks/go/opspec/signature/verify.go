@@ -0,0 +1,105 @@
+package signature
+
+import (
+	"crypto/ed25519"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// TrustedKeysDirName is the name of the dir, within a node's data dir, holding the public keys ops must be signed by
+const TrustedKeysDirName = "trusted-keys"
+
+// Verify verifies the op at 'opPath' is signed by one of 'trustedKeys'.
+//
+// The signature of the closest dir containing one, from 'opPath' up to (but excluding) 'rootPath', is verified;
+// signing a dir (i.e. a repo) signs every op within it.
+func Verify(
+	opPath string,
+	rootPath string,
+	trustedKeys []ed25519.PublicKey,
+) error {
+	signedPath, err := findSigned(opPath, rootPath)
+	if err != nil {
+		return err
+	}
+
+	signatureBytes, err := ioutil.ReadFile(filepath.Join(signedPath, FileName))
+	if err != nil {
+		return err
+	}
+
+	sig := signature{}
+	if err := json.Unmarshal(signatureBytes, &sig); err != nil {
+		return fmt.Errorf("invalid signature of '%v': %w", signedPath, err)
+	}
+
+	digest, err := Digest(signedPath)
+	if err != nil {
+		return err
+	}
+
+	if digest != sig.Digest {
+		return fmt.Errorf("content of '%v' doesn't match its signature", signedPath)
+	}
+
+	for _, trustedKey := range trustedKeys {
+		if ed25519.Verify(trustedKey, []byte(digest), sig.Signature) {
+			return nil
+		}
+	}
+
+	return fmt.Errorf("signature of '%v' isn't from a trusted key", signedPath)
+}
+
+// findSigned returns the closest dir containing a signature, from 'path' up to (but excluding) 'rootPath'
+func findSigned(
+	path string,
+	rootPath string,
+) (string, error) {
+	for candidatePath := path; isWithin(candidatePath, rootPath); candidatePath = filepath.Dir(candidatePath) {
+		if _, err := os.Stat(filepath.Join(candidatePath, FileName)); err == nil {
+			return candidatePath, nil
+		} else if !os.IsNotExist(err) {
+			return "", err
+		}
+	}
+
+	return "", fmt.Errorf("op '%v' isn't signed", path)
+}
+
+// isWithin reports whether 'path' is a descendant of 'rootPath'
+func isWithin(
+	path string,
+	rootPath string,
+) bool {
+	return strings.HasPrefix(filepath.Clean(path), filepath.Clean(rootPath)+string(os.PathSeparator))
+}
+
+// EnforcePolicy verifies the op at 'opPath' if it was pulled into 'dataCachePath' & any keys are trusted.
+//
+// Trusted keys are loaded from 'trustedKeysPath' on each call so they can be changed w/out restarting a node.
+// Ops not pulled (i.e. local ops) are never verified.
+func EnforcePolicy(
+	opPath string,
+	dataCachePath string,
+	trustedKeysPath string,
+) error {
+	if !isWithin(opPath, dataCachePath) {
+		return nil
+	}
+
+	trustedKeys, err := LoadTrustedKeys(trustedKeysPath)
+	if err != nil {
+		return err
+	}
+
+	if len(trustedKeys) == 0 {
+		return nil
+	}
+
+	return Verify(opPath, dataCachePath, trustedKeys)
+}
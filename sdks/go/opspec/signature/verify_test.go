@@ -0,0 +1,210 @@
+package signature
+
+import (
+	"crypto/ed25519"
+	"crypto/rand"
+	"crypto/x509"
+	"encoding/pem"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+// newTestKeyPair generates a key pair & writes its PEM encoded public key to 'trustedKeysPath' (if not empty)
+func newTestKeyPair(
+	trustedKeysPath string,
+) (ed25519.PublicKey, ed25519.PrivateKey) {
+	publicKey, privateKey, err := ed25519.GenerateKey(rand.Reader)
+	if err != nil {
+		panic(err)
+	}
+
+	if trustedKeysPath != "" {
+		publicKeyBytes, err := x509.MarshalPKIXPublicKey(publicKey)
+		if err != nil {
+			panic(err)
+		}
+
+		if err := os.MkdirAll(trustedKeysPath, 0777); err != nil {
+			panic(err)
+		}
+
+		keyFile, err := ioutil.TempFile(trustedKeysPath, "*.pem")
+		if err != nil {
+			panic(err)
+		}
+		defer keyFile.Close()
+
+		if err := pem.Encode(keyFile, &pem.Block{Type: "PUBLIC KEY", Bytes: publicKeyBytes}); err != nil {
+			panic(err)
+		}
+	}
+
+	return publicKey, privateKey
+}
+
+var _ = Context("Verify", func() {
+	var (
+		opPath     string
+		publicKey  ed25519.PublicKey
+		privateKey ed25519.PrivateKey
+	)
+	BeforeEach(func() {
+		opPath = newTestOp("name: test")
+		publicKey, privateKey = newTestKeyPair("")
+	})
+	Context("op signed by trusted key", func() {
+		It("should return nil", func() {
+			/* arrange */
+			if _, err := Sign(opPath, privateKey); err != nil {
+				panic(err)
+			}
+
+			/* act */
+			actualErr := Verify(opPath, filepath.Dir(opPath), []ed25519.PublicKey{publicKey})
+
+			/* assert */
+			Expect(actualErr).To(BeNil())
+		})
+	})
+	Context("ancestor signed by trusted key", func() {
+		It("should return nil", func() {
+			/* arrange */
+			childOpPath := filepath.Join(opPath, "child")
+			if err := os.MkdirAll(childOpPath, 0777); err != nil {
+				panic(err)
+			}
+			if err := ioutil.WriteFile(filepath.Join(childOpPath, "op.yml"), []byte("name: child"), 0644); err != nil {
+				panic(err)
+			}
+
+			if _, err := Sign(opPath, privateKey); err != nil {
+				panic(err)
+			}
+
+			/* act */
+			actualErr := Verify(childOpPath, filepath.Dir(opPath), []ed25519.PublicKey{publicKey})
+
+			/* assert */
+			Expect(actualErr).To(BeNil())
+		})
+	})
+	Context("op modified after signing", func() {
+		It("should return expected error", func() {
+			/* arrange */
+			if _, err := Sign(opPath, privateKey); err != nil {
+				panic(err)
+			}
+			if err := ioutil.WriteFile(filepath.Join(opPath, "op.yml"), []byte("name: tampered"), 0644); err != nil {
+				panic(err)
+			}
+
+			/* act */
+			actualErr := Verify(opPath, filepath.Dir(opPath), []ed25519.PublicKey{publicKey})
+
+			/* assert */
+			Expect(actualErr).To(MatchError("content of '" + opPath + "' doesn't match its signature"))
+		})
+	})
+	Context("op signed by untrusted key", func() {
+		It("should return expected error", func() {
+			/* arrange */
+			if _, err := Sign(opPath, privateKey); err != nil {
+				panic(err)
+			}
+			trustedKey, _ := newTestKeyPair("")
+
+			/* act */
+			actualErr := Verify(opPath, filepath.Dir(opPath), []ed25519.PublicKey{trustedKey})
+
+			/* assert */
+			Expect(actualErr).To(MatchError("signature of '" + opPath + "' isn't from a trusted key"))
+		})
+	})
+	Context("op not signed", func() {
+		It("should return expected error", func() {
+			/* act */
+			actualErr := Verify(opPath, filepath.Dir(opPath), []ed25519.PublicKey{publicKey})
+
+			/* assert */
+			Expect(actualErr).To(MatchError("op '" + opPath + "' isn't signed"))
+		})
+	})
+})
+
+var _ = Context("EnforcePolicy", func() {
+	var (
+		dataDirPath     string
+		dataCachePath   string
+		trustedKeysPath string
+		opPath          string
+	)
+	BeforeEach(func() {
+		var err error
+		dataDirPath, err = ioutil.TempDir("", "")
+		if err != nil {
+			panic(err)
+		}
+
+		dataCachePath = filepath.Join(dataDirPath, "ops")
+		trustedKeysPath = filepath.Join(dataDirPath, TrustedKeysDirName)
+
+		opPath = filepath.Join(dataCachePath, "host.com", "org", "repo#1.0.0")
+		if err := os.MkdirAll(opPath, 0777); err != nil {
+			panic(err)
+		}
+		if err := ioutil.WriteFile(filepath.Join(opPath, "op.yml"), []byte("name: test"), 0644); err != nil {
+			panic(err)
+		}
+	})
+	Context("no keys trusted", func() {
+		It("should return nil", func() {
+			/* act */
+			actualErr := EnforcePolicy(opPath, dataCachePath, trustedKeysPath)
+
+			/* assert */
+			Expect(actualErr).To(BeNil())
+		})
+	})
+	Context("keys trusted", func() {
+		var privateKey ed25519.PrivateKey
+		BeforeEach(func() {
+			_, privateKey = newTestKeyPair(trustedKeysPath)
+		})
+		Context("op not pulled", func() {
+			It("should return nil", func() {
+				/* act */
+				actualErr := EnforcePolicy(newTestOp("name: local"), dataCachePath, trustedKeysPath)
+
+				/* assert */
+				Expect(actualErr).To(BeNil())
+			})
+		})
+		Context("pulled op signed by trusted key", func() {
+			It("should return nil", func() {
+				/* arrange */
+				if _, err := Sign(opPath, privateKey); err != nil {
+					panic(err)
+				}
+
+				/* act */
+				actualErr := EnforcePolicy(opPath, dataCachePath, trustedKeysPath)
+
+				/* assert */
+				Expect(actualErr).To(BeNil())
+			})
+		})
+		Context("pulled op not signed", func() {
+			It("should return expected error", func() {
+				/* act */
+				actualErr := EnforcePolicy(opPath, dataCachePath, trustedKeysPath)
+
+				/* assert */
+				Expect(actualErr).To(MatchError("op '" + opPath + "' isn't signed"))
+			})
+		})
+	})
+})
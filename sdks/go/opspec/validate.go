@@ -3,18 +3,67 @@ package opspec
 import (
 	"context"
 
+	aggregateError "github.com/opctl/opctl/sdks/go/internal/aggregate_error"
+	"github.com/opctl/opctl/sdks/go/model"
 	"github.com/opctl/opctl/sdks/go/opspec/opfile"
+	"github.com/opctl/opctl/sdks/go/opspec/validator"
 )
 
-// Validate an op
+// Validate an op; all problems found are returned as a single error
 func Validate(
 	ctx context.Context,
 	opPath string,
 ) error {
-	_, err := opfile.Get(
+	return validate(
 		ctx,
 		opPath,
+		validator.Validate,
 	)
+}
+
+// ValidateRecursive validates an op & every child op reachable from it;
+// child ops are resolved from the filesystem (relative to their parent) & then providers.
+// All problems found are returned as a single error
+func ValidateRecursive(
+	ctx context.Context,
+	opPath string,
+	providers ...model.DataProvider,
+) error {
+	return validate(
+		ctx,
+		opPath,
+		validator.ValidateRecursive,
+		providers...,
+	)
+}
+
+func validate(
+	ctx context.Context,
+	opPath string,
+	validate func(context.Context, string, *model.OpSpec, ...model.DataProvider) []error,
+	providers ...model.DataProvider,
+) error {
+	opFile, err := opfile.Get(
+		ctx,
+		opPath,
+	)
+	if err != nil {
+		return err
+	}
+
+	errs := validate(
+		ctx,
+		opPath,
+		opFile,
+		providers...,
+	)
+	if len(errs) == 0 {
+		return nil
+	}
 
-	return err
+	var agg aggregateError.ErrAggregate
+	for _, err := range errs {
+		agg.AddError(err)
+	}
+	return agg
 }
@@ -0,0 +1,2 @@
+// Package validator exposes functionality for semantically validating ops.
+package validator
@@ -0,0 +1,97 @@
+package validator
+
+import (
+	"strings"
+
+	"github.com/opctl/opctl/sdks/go/opspec/interpreter/reference/identifier/unbracketed"
+)
+
+const (
+	escaper   = '\\'
+	operator  = '$'
+	refOpener = '('
+	refCloser = ')'
+)
+
+// refsIn returns the body of every reference within expression, nested references first.
+//
+// examples:
+// "$(name)" => ["name"]
+// "echo $(obj[$(key)])" => ["key", "obj[$(key)]"]
+// "\$(escaped)" => []
+func refsIn(
+	expression string,
+) []string {
+	refs := []string{}
+	escapesCount := 0
+
+	for i := 0; i < len(expression); i++ {
+		switch expression[i] {
+		case escaper:
+			escapesCount++
+			continue
+		case operator:
+			if escapesCount%2 == 0 && i+1 < len(expression) && expression[i+1] == refOpener {
+				if closerIndex := refCloserIndex(expression, i+1); closerIndex > 0 {
+					ref := expression[i+2 : closerIndex]
+					refs = append(refs, refsIn(ref)...)
+					refs = append(refs, ref)
+					i = closerIndex
+				}
+			}
+		}
+		escapesCount = 0
+	}
+
+	return refs
+}
+
+// refCloserIndex returns the index of the refCloser matching the refOpener at openerIndex or -1 if unclosed
+func refCloserIndex(
+	expression string,
+	openerIndex int,
+) int {
+	depth := 0
+	for i := openerIndex; i < len(expression); i++ {
+		switch expression[i] {
+		case refOpener:
+			depth++
+		case refCloser:
+			depth--
+			if depth == 0 {
+				return i
+			}
+		}
+	}
+	return -1
+}
+
+// asRef returns the body of expression if expression consists of exactly one reference
+func asRef(
+	expression string,
+) (string, bool) {
+	if len(expression) < 4 || expression[0] != operator || expression[1] != refOpener {
+		return "", false
+	}
+	if refCloserIndex(expression, 1) != len(expression)-1 {
+		return "", false
+	}
+	return expression[2 : len(expression)-1], true
+}
+
+// rootIdentifier returns the scope identifier a reference body is rooted at.
+// Op fs refs (/, ./, ../) and dynamic identifiers aren't rooted in scope.
+func rootIdentifier(
+	ref string,
+) (string, bool) {
+	if strings.HasPrefix(ref, "/") || strings.HasPrefix(ref, "./") || strings.HasPrefix(ref, "../") {
+		return "", false
+	}
+
+	identifier, _ := unbracketed.Parse(ref)
+	if identifier == "" || strings.ContainsRune(identifier, operator) {
+		return "", false
+	}
+
+	return identifier, true
+}
@@ -0,0 +1,38 @@
+package validator
+
+import (
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Context("refsIn", func() {
+	It("should return expected refs", func() {
+		/* act */
+		actualRefs := refsIn(`echo $(a) \$(escaped) $(b[$(c)].d) $(/file.txt) $(unclosed`)
+
+		/* assert */
+		Expect(actualRefs).To(Equal([]string{"a", "c", "b[$(c)].d", "/file.txt"}))
+	})
+})
+
+var _ = Context("rootIdentifier", func() {
+	Context("scope ref", func() {
+		It("should return identifier", func() {
+			/* act */
+			actualIdentifier, actualIsRooted := rootIdentifier("obj.prop[0]/file.txt")
+
+			/* assert */
+			Expect(actualIdentifier).To(Equal("obj"))
+			Expect(actualIsRooted).To(BeTrue())
+		})
+	})
+	Context("op fs ref", func() {
+		It("should return not rooted", func() {
+			/* act */
+			_, actualIsRooted := rootIdentifier("../file.txt")
+
+			/* assert */
+			Expect(actualIsRooted).To(BeFalse())
+		})
+	})
+})
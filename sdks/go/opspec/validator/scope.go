@@ -0,0 +1,85 @@
+package validator
+
+import (
+	"reflect"
+	"sort"
+)
+
+// copyScope returns a shallow copy of scope
+func copyScope(
+	scope map[string]string,
+) map[string]string {
+	scopeCopy := map[string]string{}
+	for name, valueType := range scope {
+		scopeCopy[name] = valueType
+	}
+	return scopeCopy
+}
+
+// sortedKeys returns the keys of a map w/ string keys in order so results are deterministic
+func sortedKeys(
+	m interface{},
+) []string {
+	keys := []string{}
+	for _, key := range reflect.ValueOf(m).MapKeys() {
+		keys = append(keys, key.String())
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+// closestName returns the name in scope most similar to name, if any are similar enough to likely be a typo
+func closestName(
+	name string,
+	scope map[string]string,
+) (string, bool) {
+	closest := ""
+	closestDistance := (len(name)+1)/3 + 1
+	for _, candidate := range sortedKeys(scope) {
+		if distance := editDistance(name, candidate); distance < closestDistance {
+			closest = candidate
+			closestDistance = distance
+		}
+	}
+	return closest, closest != ""
+}
+
+// editDistance returns the levenshtein distance between a & b
+func editDistance(
+	a string,
+	b string,
+) int {
+	previousRow := make([]int, len(b)+1)
+	for j := range previousRow {
+		previousRow[j] = j
+	}
+
+	for i := 1; i <= len(a); i++ {
+		currentRow := make([]int, len(b)+1)
+		currentRow[0] = i
+		for j := 1; j <= len(b); j++ {
+			substitutionCost := 1
+			if a[i-1] == b[j-1] {
+				substitutionCost = 0
+			}
+			currentRow[j] = min(
+				previousRow[j]+1,
+				currentRow[j-1]+1,
+				previousRow[j-1]+substitutionCost,
+			)
+		}
+		previousRow = currentRow
+	}
+
+	return previousRow[len(b)]
+}
+
+func min(values ...int) int {
+	result := values[0]
+	for _, value := range values[1:] {
+		if value < result {
+			result = value
+		}
+	}
+	return result
+}
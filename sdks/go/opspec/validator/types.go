@@ -0,0 +1,132 @@
+package validator
+
+import (
+	"github.com/opctl/opctl/sdks/go/model"
+	"github.com/opctl/opctl/sdks/go/opspec/interpreter/reference/identifier/unbracketed"
+)
+
+const (
+	typeArray   = "array"
+	typeBoolean = "boolean"
+	typeDir     = "dir"
+	typeFile    = "file"
+	typeNumber  = "number"
+	typeObject  = "object"
+	typeSocket  = "socket"
+	typeString  = "string"
+	// typeUnknown is used when a type can't be determined statically
+	typeUnknown = ""
+)
+
+// coercibleTypesByType mirrors the coercions supported by data/coerce;
+// format: targetType => sourceType => isCoercible
+var coercibleTypesByType = map[string]map[string]bool{
+	typeArray:   {typeArray: true, typeFile: true, typeString: true},
+	typeBoolean: {typeArray: true, typeBoolean: true, typeFile: true, typeNumber: true, typeObject: true, typeString: true},
+	typeDir:     {typeDir: true, typeObject: true},
+	typeFile:    {typeArray: true, typeBoolean: true, typeFile: true, typeNumber: true, typeObject: true, typeString: true},
+	typeNumber:  {typeFile: true, typeNumber: true, typeString: true},
+	typeObject:  {typeFile: true, typeObject: true, typeString: true},
+	typeSocket:  {typeSocket: true},
+	typeString:  {typeArray: true, typeBoolean: true, typeFile: true, typeNumber: true, typeObject: true, typeString: true},
+}
+
+// isCoercible tests if a value of sourceType can be coerced to targetType;
+// unknown types are assumed coercible
+func isCoercible(
+	sourceType string,
+	targetType string,
+) bool {
+	if sourceType == typeUnknown || targetType == typeUnknown {
+		return true
+	}
+	return coercibleTypesByType[targetType][sourceType]
+}
+
+// paramType returns the type of a param
+func paramType(
+	param *model.Param,
+) string {
+	switch {
+	case param == nil:
+		return typeUnknown
+	case param.Array != nil:
+		return typeArray
+	case param.Boolean != nil:
+		return typeBoolean
+	case param.Dir != nil:
+		return typeDir
+	case param.File != nil:
+		return typeFile
+	case param.Number != nil:
+		return typeNumber
+	case param.Object != nil:
+		return typeObject
+	case param.Socket != nil:
+		return typeSocket
+	case param.String != nil:
+		return typeString
+	default:
+		return typeUnknown
+	}
+}
+
+// paramHasDefault tests if a param declares a default
+func paramHasDefault(
+	param *model.Param,
+) bool {
+	switch {
+	case param.Array != nil:
+		return param.Array.Default != nil
+	case param.Boolean != nil:
+		return param.Boolean.Default != nil
+	case param.Dir != nil:
+		return param.Dir.Default != nil
+	case param.File != nil:
+		return param.File.Default != nil
+	case param.Number != nil:
+		return param.Number.Default != nil
+	case param.Object != nil:
+		return param.Object.Default != nil
+	case param.String != nil:
+		return param.String.Default != nil
+	default:
+		return false
+	}
+}
+
+// expressionType statically determines the type an expression will be interpreted to
+func expressionType(
+	expression interface{},
+	scope map[string]string,
+) string {
+	switch expression := expression.(type) {
+	case bool:
+		return typeBoolean
+	case float64, int:
+		return typeNumber
+	case map[string]interface{}:
+		return typeObject
+	case []interface{}:
+		return typeArray
+	case string:
+		ref, isRef := asRef(expression)
+		if !isRef {
+			return typeString
+		}
+
+		identifier, isRooted := rootIdentifier(ref)
+		if !isRooted {
+			return typeUnknown
+		}
+
+		if _, remainder := unbracketed.Parse(ref); remainder != "" {
+			// property, item, or path of identifier
+			return typeUnknown
+		}
+
+		return scope[identifier]
+	default:
+		return typeUnknown
+	}
+}
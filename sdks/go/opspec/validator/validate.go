@@ -0,0 +1,827 @@
+package validator
+
+import (
+	"context"
+	"fmt"
+	"path/filepath"
+	"strings"
+
+	"github.com/opctl/opctl/sdks/go/data"
+	"github.com/opctl/opctl/sdks/go/data/fs"
+	"github.com/opctl/opctl/sdks/go/model"
+	"github.com/opctl/opctl/sdks/go/opspec/internal/varref"
+	"github.com/opctl/opctl/sdks/go/opspec/interpreter/call/dag"
+	"github.com/opctl/opctl/sdks/go/opspec/opfile"
+)
+
+// Validate semantically validates an op; i.e. checks what the op.yml schema can't:
+//   - references resolve against declared inputs, loop vars & prior outputs
+//   - needs refer to sibling calls
+//   - child op input & output bindings match the child ops declared params & their types
+//
+// child ops are resolved from the filesystem (relative to the op) & then providers;
+// if no providers are given, remote child ops (host/path#version) aren't resolved.
+//
+// all problems found are returned
+func Validate(
+	ctx context.Context,
+	opPath string,
+	opSpec *model.OpSpec,
+	providers ...model.DataProvider,
+) []error {
+	v := newValidator(ctx, opPath, providers, nil)
+	v.validateOp(opSpec)
+	return v.errs
+}
+
+// ValidateRecursive semantically validates an op (see Validate) & every child op reachable from it.
+// Each child op is validated once, regardless of how many times it's referenced.
+//
+// all problems found are returned
+func ValidateRecursive(
+	ctx context.Context,
+	opPath string,
+	opSpec *model.OpSpec,
+	providers ...model.DataProvider,
+) []error {
+	v := newValidator(ctx, opPath, providers, map[string]bool{opPath: true})
+	v.validateOp(opSpec)
+	return v.errs
+}
+
+func newValidator(
+	ctx context.Context,
+	opPath string,
+	providers []model.DataProvider,
+	validatedOpPaths map[string]bool,
+) *_validator {
+	return &_validator{
+		ctx:              ctx,
+		opPath:           opPath,
+		providers:        providers,
+		validatedOpPaths: validatedOpPaths,
+	}
+}
+
+type _validator struct {
+	ctx context.Context
+	// opInputs are the inputs of the op being validated
+	opInputs  map[string]*model.Param
+	opPath    string
+	providers []model.DataProvider
+	// validatedOpPaths tracks ops already validated; nil if not validating recursively
+	validatedOpPaths map[string]bool
+	errs             []error
+}
+
+func (v *_validator) validateOp(
+	opSpec *model.OpSpec,
+) {
+	v.opInputs = opSpec.Inputs
+
+	scope := map[string]string{}
+	for name, param := range opSpec.Inputs {
+		scope[name] = paramType(param)
+	}
+
+	if opSpec.Run != nil {
+		outputs := v.validateCall("run", opSpec.Run, scope)
+
+		if opSpec.Finally != nil {
+			for name, outputType := range v.validateFinally("finally", opSpec.Finally, outputs) {
+				outputs[name] = outputType
+			}
+		}
+
+		for _, name := range sortedKeys(opSpec.Outputs) {
+			param := opSpec.Outputs[name]
+			if _, ok := outputs[name]; !ok && !paramHasDefault(param) {
+				v.addError("outputs", "output '%v' never bound by run", name)
+			}
+		}
+	}
+}
+
+func (v *_validator) addError(
+	path string,
+	format string,
+	args ...interface{},
+) {
+	v.errs = append(v.errs, fmt.Errorf("%v: %v", path, fmt.Sprintf(format, args...)))
+}
+
+// validateCall validates a call against scope
+// returns the scope the call outputs to its parent
+func (v *_validator) validateCall(
+	path string,
+	callSpec *model.CallSpec,
+	scope map[string]string,
+) map[string]string {
+	if callSpec.If != nil {
+		v.validatePredicates(path+".if", *callSpec.If, scope)
+	}
+
+	if callSpec.FailFast != nil && callSpec.Parallel == nil {
+		v.addError(path+".failFast", "only applies to parallel calls; use parallelLoop.failFast for parallelLoop calls")
+	}
+
+	if callSpec.Finally != nil && callSpec.Serial == nil {
+		v.addError(path+".finally", "only applies to serial calls")
+	}
+
+	outputs := v.validateCallGraph(path, callSpec, scope)
+
+	if callSpec.Else != nil {
+		if callSpec.If == nil {
+			v.addError(path+".else", "requires if")
+		}
+		for name, outputType := range v.validateCall(path+".else", callSpec.Else, scope) {
+			outputs[name] = outputType
+		}
+	}
+
+	return outputs
+}
+
+// validateCallGraph validates the call graph (container, op, serial, etc.) of a call against scope
+// returns the scope the call graph outputs to its parent
+func (v *_validator) validateCallGraph(
+	path string,
+	callSpec *model.CallSpec,
+	scope map[string]string,
+) map[string]string {
+	switch {
+	case callSpec.Container != nil:
+		return v.validateContainerCall(path+".container", callSpec.Container, scope)
+	case callSpec.Dag != nil:
+		return v.validateDagCall(path+".dag", *callSpec.Dag, scope)
+	case callSpec.Op != nil:
+		return v.validateOpCall(path+".op", callSpec.Op, scope)
+	case callSpec.Parallel != nil:
+		return v.validateParallelCall(path+".parallel", *callSpec.Parallel, scope)
+	case callSpec.ParallelLoop != nil:
+		return v.validateParallelLoopCall(path+".parallelLoop", callSpec.ParallelLoop, scope)
+	case callSpec.Serial != nil:
+		outputs := v.validateSerialCall(path+".serial", *callSpec.Serial, scope)
+		if callSpec.Finally != nil {
+			for name, outputType := range v.validateFinally(path+".finally", callSpec.Finally, outputs) {
+				outputs[name] = outputType
+			}
+		}
+		return outputs
+	case callSpec.SerialLoop != nil:
+		return v.validateSerialLoopCall(path+".serialLoop", callSpec.SerialLoop, scope)
+	case callSpec.Switch != nil:
+		return v.validateSwitchCall(path+".switch", callSpec.Switch, scope)
+	}
+	return map[string]string{}
+}
+
+func (v *_validator) validateContainerCall(
+	path string,
+	containerCallSpec *model.ContainerCallSpec,
+	scope map[string]string,
+) map[string]string {
+	outputs := map[string]string{}
+
+	for i, cmdEntry := range containerCallSpec.Cmd {
+		v.validateExpression(fmt.Sprintf("%v.cmd[%v]", path, i), cmdEntry, scope)
+	}
+
+	for _, containerPath := range sortedKeys(containerCallSpec.Dirs) {
+		v.validateMount(path+".dirs."+containerPath, containerCallSpec.Dirs[containerPath], scope)
+		if name, ok := mountOutputName(containerCallSpec.Dirs[containerPath]); ok {
+			outputs[name] = typeDir
+		}
+	}
+
+	v.validateExpression(path+".envVars", containerCallSpec.EnvVars, scope)
+
+	for _, containerPath := range sortedKeys(containerCallSpec.Files) {
+		v.validateMount(path+".files."+containerPath, containerCallSpec.Files[containerPath], scope)
+		if name, ok := mountOutputName(containerCallSpec.Files[containerPath]); ok {
+			outputs[name] = typeFile
+		}
+	}
+
+	if image := containerCallSpec.Image; image != nil {
+		v.validateExpression(path+".image.ref", image.Ref, scope)
+		v.validateCreds(path+".image.pullCreds", image.PullCreds, scope)
+	}
+
+	if containerCallSpec.Name != nil {
+		v.validateExpression(path+".name", *containerCallSpec.Name, scope)
+	}
+
+	v.validateExpression(path+".workDir", containerCallSpec.WorkDir, scope)
+
+	for socketAddress, socketBind := range containerCallSpec.Sockets {
+		// sockets not in scope are outputs so can't be invalid
+		if socketAddress == "0.0.0.0" {
+			outputs[varref.ToName(socketBind)] = typeSocket
+		}
+	}
+
+	return outputs
+}
+
+// validateMount validates a container dir/file mount; refs to names not in scope are valid
+// because they're created & output by the container
+func (v *_validator) validateMount(
+	path string,
+	expression interface{},
+	scope map[string]string,
+) {
+	if expression, ok := expression.(string); ok {
+		if ref, isRef := asRef(expression); isRef {
+			// only nested refs must resolve
+			for _, nestedRef := range refsIn(ref) {
+				v.validateRef(path, nestedRef, scope)
+			}
+			return
+		}
+	}
+
+	v.validateExpression(path, expression, scope)
+}
+
+func (v *_validator) validateCreds(
+	path string,
+	credsSpec *model.CredsSpec,
+	scope map[string]string,
+) {
+	if credsSpec == nil {
+		return
+	}
+	v.validateExpression(path+".username", credsSpec.Username, scope)
+	v.validateExpression(path+".password", credsSpec.Password, scope)
+}
+
+func (v *_validator) validateOpCall(
+	path string,
+	opCallSpec *model.OpCallSpec,
+	scope map[string]string,
+) map[string]string {
+	outputs := map[string]string{}
+
+	v.validateCreds(path+".pullCreds", opCallSpec.PullCreds, scope)
+
+	var opFile *model.OpSpec
+	if _, isRef := asRef(opCallSpec.Ref); isRef {
+		// op determined at runtime; only the ref itself can be validated
+		v.validateExpression(path+".ref", opCallSpec.Ref, scope)
+	} else {
+		opFile = v.validateChildOp(path, opCallSpec.Ref)
+	}
+
+	for _, name := range sortedKeys(opCallSpec.Inputs) {
+		var param *model.Param
+		if opFile != nil {
+			param = opFile.Inputs[name]
+			if param == nil {
+				v.addError(path+".inputs."+name, "unable to bind to '%v': '%v' not a defined input", name, name)
+				continue
+			}
+		}
+		v.validateInput(path+".inputs."+name, name, opCallSpec.Inputs[name], param, scope)
+	}
+
+	if opFile != nil {
+		for _, name := range sortedKeys(opFile.Inputs) {
+			param := opFile.Inputs[name]
+			if _, isBound := opCallSpec.Inputs[name]; !isBound && !paramHasDefault(param) {
+				v.addError(path+".inputs", "required input '%v' not bound", name)
+			}
+		}
+	}
+
+	for _, name := range sortedKeys(opCallSpec.Outputs) {
+		boundRef := opCallSpec.Outputs[name]
+		if _, isRef := asRef(boundRef); boundRef != "" && !isRef {
+			// handle obsolete syntax by swapping order the same way it's handled at runtime
+			name, boundRef = boundRef, varref.FromName(name)
+		}
+
+		var param *model.Param
+		if opFile != nil {
+			param = opFile.Outputs[name]
+			if param == nil {
+				if _, ok := opFile.Outputs[varref.ToName(boundRef)]; ok {
+					v.addError(path+".outputs."+name, "unknown output '%v', did you mean to use `%v: %v`?", name, varref.ToName(boundRef), varref.FromName(name))
+				} else {
+					v.addError(path+".outputs."+name, "unknown output '%v'", name)
+				}
+				continue
+			}
+		}
+
+		boundName := name
+		if boundRef != "" {
+			boundName = varref.ToName(boundRef)
+		}
+		outputs[boundName] = paramType(param)
+	}
+
+	return outputs
+}
+
+// validateChildOp resolves & gets the op referenced by opRef; if validating recursively the op is validated too.
+// returns nil if the op couldn't be resolved or is invalid
+func (v *_validator) validateChildOp(
+	path string,
+	opRef string,
+) *model.OpSpec {
+	opHandle, err := data.Resolve(
+		v.ctx,
+		opRef,
+		append(
+			[]model.DataProvider{fs.New(v.opPath, filepath.Dir(v.opPath))},
+			v.providers...,
+		)...,
+	)
+	if err != nil {
+		if len(v.providers) > 0 || !strings.Contains(opRef, "#") {
+			// without providers, remote refs (host/path#version) aren't resolved; local ones must be
+			v.addError(path+".ref", "%v", err)
+		}
+		return nil
+	}
+
+	opPath := opHandle.Path()
+	if opPath == nil {
+		v.addError(path+".ref", "unable to validate op '%v': op not available locally", opRef)
+		return nil
+	}
+
+	opFile, err := opfile.Get(v.ctx, *opPath)
+	if err != nil {
+		v.addError(path+".ref", "invalid op '%v': %v", opRef, err)
+		return nil
+	}
+
+	if v.validatedOpPaths != nil && !v.validatedOpPaths[*opPath] {
+		v.validatedOpPaths[*opPath] = true
+
+		childValidator := newValidator(v.ctx, *opPath, v.providers, v.validatedOpPaths)
+		childValidator.validateOp(opFile)
+		for _, err := range childValidator.errs {
+			v.addError(path, "%v: %v", opRef, err)
+		}
+	}
+
+	return opFile
+}
+
+// validateInput validates the binding of expression to an op input;
+// param will be nil if the op couldn't be resolved
+func (v *_validator) validateInput(
+	path string,
+	name string,
+	expression interface{},
+	param *model.Param,
+	scope map[string]string,
+) {
+	targetType := paramType(param)
+
+	if expression == nil || expression == "" {
+		// implicitly bound
+		sourceType, ok := scope[name]
+		if !ok {
+			v.addError(path, "unable to bind to '%v' via implicit ref: '%v' not in scope", name, name)
+		} else if !isCoercible(sourceType, targetType) {
+			v.addError(path, "unable to bind %v '%v' to %v input", sourceType, varref.FromName(name), targetType)
+		}
+		return
+	}
+
+	if expressionString, ok := expression.(string); ok && (targetType == typeDir || targetType == typeFile) {
+		if ref, isRef := asRef(expressionString); isRef {
+			if identifier, isRooted := rootIdentifier(ref); isRooted {
+				if _, inScope := scope[identifier]; !inScope {
+					// dir & file inputs create refs not in scope
+					return
+				}
+			}
+		}
+	}
+
+	if targetType == typeSocket {
+		if expressionString, ok := expression.(string); !ok {
+			v.addError(path, "unable to bind '%v' to '%+v': sockets must be passed by reference", name, expression)
+			return
+		} else if _, isRef := asRef(expressionString); !isRef {
+			v.addError(path, "unable to bind '%v' to '%+v': sockets must be passed by reference", name, expression)
+			return
+		}
+	}
+
+	if !v.validateExpression(path, expression, scope) {
+		return
+	}
+
+	if sourceType := expressionType(expression, scope); !isCoercible(sourceType, targetType) {
+		v.addError(path, "unable to bind %v '%+v' to %v input", sourceType, expression, targetType)
+	}
+}
+
+func (v *_validator) validateDagCall(
+	path string,
+	callSpecs []*model.CallSpec,
+	scope map[string]string,
+) map[string]string {
+	names := v.validateSiblingNames(path, callSpecs)
+
+	isNeedsValid := true
+	for i, callSpec := range callSpecs {
+		isNeedsValid = v.validateNeeds(fmt.Sprintf("%v[%v]", path, i), callSpec, names) && isNeedsValid
+	}
+
+	var neededIndicesByIndex [][]int
+	if isNeedsValid {
+		var err error
+		if neededIndicesByIndex, err = dag.Needs(callSpecs); err != nil {
+			// needs refer to siblings so must form a cycle
+			v.addError(path, "%v", err)
+		}
+	}
+
+	// children are validated w/ the outputs of the calls they need (directly or transitively) in scope
+	outputsByIndex := make([]map[string]string, len(callSpecs))
+	var validateChild func(i int)
+	validateChild = func(i int) {
+		if outputsByIndex[i] != nil {
+			return
+		}
+
+		childScope := copyScope(scope)
+		if neededIndicesByIndex != nil {
+			for _, ancestorIndex := range dag.Ancestors(neededIndicesByIndex, i) {
+				validateChild(ancestorIndex)
+				for name, outputType := range outputsByIndex[ancestorIndex] {
+					childScope[name] = outputType
+				}
+			}
+		}
+
+		outputsByIndex[i] = map[string]string{}
+		for name, outputType := range v.validateCall(fmt.Sprintf("%v[%v]", path, i), callSpecs[i], childScope) {
+			outputsByIndex[i][name] = outputType
+		}
+	}
+
+	outputs := map[string]string{}
+	for i := range callSpecs {
+		validateChild(i)
+		for name, outputType := range outputsByIndex[i] {
+			outputs[name] = outputType
+		}
+	}
+
+	return outputs
+}
+
+func (v *_validator) validateParallelCall(
+	path string,
+	callSpecs []*model.CallSpec,
+	scope map[string]string,
+) map[string]string {
+	outputs := map[string]string{}
+
+	names := v.validateSiblingNames(path, callSpecs)
+
+	for i, callSpec := range callSpecs {
+		childPath := fmt.Sprintf("%v[%v]", path, i)
+
+		v.validateNeeds(childPath, callSpec, names)
+
+		for name, outputType := range v.validateCall(childPath, callSpec, scope) {
+			outputs[name] = outputType
+		}
+	}
+
+	return outputs
+}
+
+// validateSiblingNames validates the names of sibling calls are unique; returns the names
+func (v *_validator) validateSiblingNames(
+	path string,
+	callSpecs []*model.CallSpec,
+) map[string]bool {
+	names := map[string]bool{}
+	for i, callSpec := range callSpecs {
+		if callSpec.Name != nil {
+			if names[*callSpec.Name] {
+				v.addError(fmt.Sprintf("%v[%v].name", path, i), "duplicate call name '%v'", *callSpec.Name)
+			}
+			names[*callSpec.Name] = true
+		}
+	}
+	return names
+}
+
+// validateNeeds validates the needs of a call refer to siblings; returns true if valid
+func (v *_validator) validateNeeds(
+	path string,
+	callSpec *model.CallSpec,
+	siblingNames map[string]bool,
+) bool {
+	isValid := true
+	for _, neededRef := range callSpec.Needs {
+		neededName := varref.ToName(neededRef)
+		if !siblingNames[neededName] || (callSpec.Name != nil && *callSpec.Name == neededName) {
+			v.addError(path+".needs", "'%v' not the name of a sibling call", neededName)
+			isValid = false
+		}
+	}
+	return isValid
+}
+
+func (v *_validator) validateSerialCall(
+	path string,
+	callSpecs []*model.CallSpec,
+	scope map[string]string,
+) map[string]string {
+	outputs := copyScope(scope)
+
+	for i, callSpec := range callSpecs {
+		for name, outputType := range v.validateCall(fmt.Sprintf("%v[%v]", path, i), callSpec, outputs) {
+			outputs[name] = outputType
+		}
+	}
+
+	return outputs
+}
+
+// validateFinally validates a finally call against scope w/ the outcome & error of the call it follows
+func (v *_validator) validateFinally(
+	path string,
+	callSpec *model.CallSpec,
+	scope map[string]string,
+) map[string]string {
+	for _, name := range []string{"outcome", "error"} {
+		if _, isInput := v.opInputs[name]; isInput {
+			v.addError(path, "input '%v' would be shadowed by the %v of the call finally follows; rename it", name, name)
+		}
+	}
+
+	finallyScope := copyScope(scope)
+	finallyScope["outcome"] = typeString
+	finallyScope["error"] = typeString
+
+	outputs := v.validateCall(path, callSpec, finallyScope)
+	for _, name := range []string{"outcome", "error"} {
+		if _, ok := scope[name]; !ok {
+			// only in scope of the finally call
+			delete(outputs, name)
+		}
+	}
+	return outputs
+}
+
+func (v *_validator) validateSwitchCall(
+	path string,
+	callSpec *model.SwitchCallSpec,
+	scope map[string]string,
+) map[string]string {
+	outputs := map[string]string{}
+
+	for i, caseSpec := range callSpec.Cases {
+		casePath := fmt.Sprintf("%v.cases[%v]", path, i)
+		v.validatePredicates(casePath+".if", caseSpec.If, scope)
+		for name, outputType := range v.validateCall(casePath+".run", &caseSpec.Run, scope) {
+			outputs[name] = outputType
+		}
+	}
+
+	if callSpec.Default != nil {
+		for name, outputType := range v.validateCall(path+".default", callSpec.Default, scope) {
+			outputs[name] = outputType
+		}
+	}
+
+	return outputs
+}
+
+func (v *_validator) validateParallelLoopCall(
+	path string,
+	callSpec *model.ParallelLoopCallSpec,
+	scope map[string]string,
+) map[string]string {
+	if callSpec.MaxConcurrency != nil {
+		v.validateExpression(path+".maxConcurrency", callSpec.MaxConcurrency, scope)
+	}
+	if callSpec.Matrix != nil {
+		v.validateExpression(path+".matrix", callSpec.Matrix, scope)
+	}
+	v.validateExpression(path+".range", callSpec.Range, scope)
+
+	iterationScope := v.loopScope(callSpec.Range, callSpec.Vars, scope)
+
+	outputs := copyScope(scope)
+	runOutputs := v.validateCall(path+".run", &callSpec.Run, iterationScope)
+	for name, outputType := range runOutputs {
+		outputs[name] = outputType
+	}
+
+	return v.collect(path+".collect", callSpec.Collect, runOutputs, deScope(callSpec.Vars, scope, outputs))
+}
+
+func (v *_validator) validateSerialLoopCall(
+	path string,
+	callSpec *model.SerialLoopCallSpec,
+	scope map[string]string,
+) map[string]string {
+	if callSpec.Delay != nil {
+		v.validateExpression(path+".delay", callSpec.Delay, scope)
+	}
+	if callSpec.MaxIterations != nil {
+		v.validateExpression(path+".maxIterations", callSpec.MaxIterations, scope)
+	}
+	if callSpec.Matrix != nil {
+		v.validateExpression(path+".matrix", callSpec.Matrix, scope)
+	}
+	v.validateExpression(path+".range", callSpec.Range, scope)
+
+	iterationScope := v.loopScope(callSpec.Range, callSpec.Vars, scope)
+
+	outputs := copyScope(iterationScope)
+	runOutputs := v.validateCall(path+".run", &callSpec.Run, iterationScope)
+	for name, outputType := range runOutputs {
+		outputs[name] = outputType
+	}
+
+	// until is evaluated against the outputs of prior iterations
+	v.validatePredicates(path+".until", callSpec.Until, outputs)
+
+	return v.collect(path+".collect", callSpec.Collect, runOutputs, deScope(callSpec.Vars, scope, outputs))
+}
+
+// collect validates outputs collected from loop iterations are output by the loop's run
+// returns scope w/ collected outputs added
+func (v *_validator) collect(
+	path string,
+	collectSpec []string,
+	runOutputs map[string]string,
+	scope map[string]string,
+) map[string]string {
+	if len(collectSpec) == 0 {
+		return scope
+	}
+
+	outputs := copyScope(scope)
+	for _, outputRef := range collectSpec {
+		name := varref.ToName(outputRef)
+		if _, ok := runOutputs[name]; !ok {
+			v.addError(path, "'%v' never output by run", name)
+		}
+		// an array or object depending on the loop's range
+		outputs[name] = typeUnknown
+	}
+	return outputs
+}
+
+// loopScope returns scope w/ loop vars added
+func (v *_validator) loopScope(
+	loopRange interface{},
+	loopVarsSpec *model.LoopVarsSpec,
+	scope map[string]string,
+) map[string]string {
+	iterationScope := copyScope(scope)
+	if loopVarsSpec == nil {
+		return iterationScope
+	}
+
+	if loopVarsSpec.Index != nil {
+		iterationScope[varref.ToName(*loopVarsSpec.Index)] = typeNumber
+	}
+	if loopVarsSpec.Key != nil {
+		iterationScope[varref.ToName(*loopVarsSpec.Key)] = typeString
+	}
+	if loopVarsSpec.Value != nil {
+		iterationScope[varref.ToName(*loopVarsSpec.Value)] = typeUnknown
+	}
+
+	return iterationScope
+}
+
+// deScope restores loop vars shadowed in a loop
+func deScope(
+	loopVarsSpec *model.LoopVarsSpec,
+	parentScope map[string]string,
+	iterationScope map[string]string,
+) map[string]string {
+	if loopVarsSpec == nil {
+		return iterationScope
+	}
+
+	outboundScope := copyScope(iterationScope)
+	for _, loopVar := range []*string{loopVarsSpec.Index, loopVarsSpec.Key, loopVarsSpec.Value} {
+		if loopVar == nil {
+			continue
+		}
+		name := varref.ToName(*loopVar)
+		if parentType, ok := parentScope[name]; ok {
+			outboundScope[name] = parentType
+		} else {
+			delete(outboundScope, name)
+		}
+	}
+
+	return outboundScope
+}
+
+func (v *_validator) validatePredicates(
+	path string,
+	predicateSpecs []*model.PredicateSpec,
+	scope map[string]string,
+) {
+	for i, predicateSpec := range predicateSpecs {
+		// exists & notExists test resolvability so are valid regardless of scope
+		if predicateSpec.Eq != nil {
+			v.validateExpression(fmt.Sprintf("%v[%v].eq", path, i), *predicateSpec.Eq, scope)
+		}
+		if predicateSpec.Ne != nil {
+			v.validateExpression(fmt.Sprintf("%v[%v].ne", path, i), *predicateSpec.Ne, scope)
+		}
+	}
+}
+
+// validateExpression validates every reference within expression resolves against scope
+// returns false if any don't
+func (v *_validator) validateExpression(
+	path string,
+	expression interface{},
+	scope map[string]string,
+) bool {
+	isValid := true
+
+	switch expression := expression.(type) {
+	case string:
+		for _, ref := range refsIn(expression) {
+			isValid = v.validateRef(path, ref, scope) && isValid
+		}
+	case map[string]interface{}:
+		for _, key := range sortedKeys(expression) {
+			isValid = v.validateExpression(path, key, scope) && isValid
+			if expression[key] == nil {
+				// implicit reference
+				isValid = v.validateRef(path, key, scope) && isValid
+			} else {
+				isValid = v.validateExpression(path, expression[key], scope) && isValid
+			}
+		}
+	case []interface{}:
+		for _, item := range expression {
+			isValid = v.validateExpression(path, item, scope) && isValid
+		}
+	}
+
+	return isValid
+}
+
+// validateRef validates a reference resolves against scope
+func (v *_validator) validateRef(
+	path string,
+	ref string,
+	scope map[string]string,
+) bool {
+	identifier, isRooted := rootIdentifier(ref)
+	if !isRooted {
+		return true
+	}
+
+	if _, ok := scope[identifier]; ok {
+		return true
+	}
+
+	if suggestion, ok := closestName(identifier, scope); ok {
+		v.addError(path, "unable to resolve '%v': '%v' not in scope, did you mean '%v'?", varref.FromName(ref), identifier, suggestion)
+	} else {
+		v.addError(path, "unable to resolve '%v': '%v' not in scope", varref.FromName(ref), identifier)
+	}
+
+	return false
+}
+
+// mountOutputName returns the name a container dir/file mount outputs to (if any);
+// only mounts of plain identifier refs i.e. $(name) output, not op fs refs (i.e. $(/dir)) or paths of them
+func mountOutputName(
+	expression interface{},
+) (string, bool) {
+	expressionString, ok := expression.(string)
+	if !ok {
+		return "", false
+	}
+
+	ref, ok := asRef(expressionString)
+	if !ok {
+		return "", false
+	}
+
+	identifier, isRooted := rootIdentifier(ref)
+	if !isRooted || identifier != ref {
+		return "", false
+	}
+
+	return identifier, true
+}
@@ -0,0 +1,635 @@
+package validator
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/opctl/opctl/sdks/go/data/fs"
+	"github.com/opctl/opctl/sdks/go/model"
+	"github.com/opctl/opctl/sdks/go/opspec/opfile"
+)
+
+var _ = Context("Validate", func() {
+	wd, err := os.Getwd()
+	if err != nil {
+		panic(err)
+	}
+
+	Context("op valid", func() {
+		It("should return no errors", func() {
+			/* arrange */
+			opPath := filepath.Join(wd, "testdata/valid")
+			opFile, err := opfile.Get(context.Background(), opPath)
+			if err != nil {
+				panic(err)
+			}
+
+			/* act */
+			actualErrs := Validate(
+				context.Background(),
+				opPath,
+				opFile,
+			)
+
+			/* assert */
+			Expect(actualErrs).To(BeEmpty())
+		})
+	})
+	Context("op invalid", func() {
+		It("should return all errors", func() {
+			/* arrange */
+			opPath := filepath.Join(wd, "testdata/invalid")
+			opFile, err := opfile.Get(context.Background(), opPath)
+			if err != nil {
+				panic(err)
+			}
+
+			/* act */
+			actualErrs := Validate(
+				context.Background(),
+				opPath,
+				opFile,
+			)
+
+			/* assert */
+			Expect(actualErrs).To(HaveLen(6))
+			Expect(actualErrs[0]).To(MatchError("run.serial[0].parallel[0].container.cmd[1]: unable to resolve '$(inptu)': 'inptu' not in scope, did you mean 'input'?"))
+			Expect(actualErrs[1]).To(MatchError("run.serial[0].parallel[1].needs: 'frist' not the name of a sibling call"))
+			Expect(actualErrs[2]).To(MatchError("run.serial[1].op.inputs.dir: unable to bind string '$(input)' to dir input"))
+			Expect(actualErrs[3]).To(MatchError("run.serial[1].op.inputs.undefined: unable to bind to 'undefined': 'undefined' not a defined input"))
+			Expect(actualErrs[4]).To(MatchError("run.serial[1].op.outputs.undefined: unknown output 'undefined'"))
+			Expect(actualErrs[5]).To(MatchError("outputs: output 'result' never bound by run"))
+		})
+	})
+	Context("child op invalid", func() {
+		It("should not validate child op", func() {
+			/* arrange */
+			opPath := filepath.Join(wd, "testdata/recursive")
+			opFile, err := opfile.Get(context.Background(), opPath)
+			if err != nil {
+				panic(err)
+			}
+
+			/* act */
+			actualErrs := Validate(
+				context.Background(),
+				opPath,
+				opFile,
+			)
+
+			/* assert */
+			Expect(actualErrs).To(BeEmpty())
+		})
+	})
+	Context("child op unbound required input", func() {
+		It("should return expected error", func() {
+			/* act */
+			actualErrs := Validate(
+				context.Background(),
+				filepath.Join(wd, "testdata/valid"),
+				&model.OpSpec{
+					Run: &model.CallSpec{
+						Op: &model.OpCallSpec{
+							Ref: "../child",
+						},
+					},
+				},
+			)
+
+			/* assert */
+			Expect(actualErrs).To(ConsistOf(MatchError("run.op.inputs: required input 'dir' not bound")))
+		})
+	})
+	Context("child op outputs bound w/ obsolete syntax", func() {
+		It("should return no errors", func() {
+			/* arrange */
+			obsoleteRef := "$(obsoleteBound)"
+
+			/* act */
+			actualErrs := Validate(
+				context.Background(),
+				filepath.Join(wd, "testdata/valid"),
+				&model.OpSpec{
+					Inputs: map[string]*model.Param{
+						"srcDir": {Dir: &model.DirParam{}},
+					},
+					Run: &model.CallSpec{
+						Serial: &[]*model.CallSpec{
+							{
+								Op: &model.OpCallSpec{
+									Ref: "../child",
+									Inputs: map[string]interface{}{
+										"dir": "$(srcDir)",
+									},
+									// { parentName: childOutput }
+									Outputs: map[string]string{
+										"obsoleteBound": "file",
+									},
+								},
+							},
+							{
+								Container: &model.ContainerCallSpec{
+									Image: &model.ContainerCallImageSpec{Ref: "alpine"},
+									Cmd:   []interface{}{"cat", obsoleteRef},
+								},
+							},
+						},
+					},
+				},
+			)
+
+			/* assert */
+			Expect(actualErrs).To(BeEmpty())
+		})
+	})
+	Context("finally", func() {
+		Context("on non serial call", func() {
+			It("should return expected error", func() {
+				/* act */
+				actualErrs := Validate(
+					context.Background(),
+					wd,
+					&model.OpSpec{
+						Run: &model.CallSpec{
+							Finally:  &model.CallSpec{Serial: &[]*model.CallSpec{}},
+							Parallel: &[]*model.CallSpec{},
+						},
+					},
+				)
+
+				/* assert */
+				Expect(actualErrs).To(ConsistOf(MatchError("run.finally: only applies to serial calls")))
+			})
+		})
+		Context("op has input named outcome", func() {
+			It("should return expected error", func() {
+				/* act */
+				actualErrs := Validate(
+					context.Background(),
+					wd,
+					&model.OpSpec{
+						Inputs: map[string]*model.Param{
+							"outcome": {String: &model.StringParam{}},
+						},
+						Run: &model.CallSpec{
+							Serial: &[]*model.CallSpec{},
+						},
+						Finally: &model.CallSpec{
+							Container: &model.ContainerCallSpec{
+								Cmd: []interface{}{"$(outcome)"},
+							},
+						},
+					},
+				)
+
+				/* assert */
+				Expect(actualErrs).To(ConsistOf(MatchError("finally: input 'outcome' would be shadowed by the outcome of the call finally follows; rename it")))
+			})
+		})
+		Context("refs outcome, error, & serial outputs", func() {
+			It("should return no errors", func() {
+				/* act */
+				actualErrs := Validate(
+					context.Background(),
+					wd,
+					&model.OpSpec{
+						Run: &model.CallSpec{
+							Serial: &[]*model.CallSpec{
+								{
+									Container: &model.ContainerCallSpec{
+										Files: map[string]interface{}{"/id": "$(id)"},
+									},
+								},
+							},
+							Finally: &model.CallSpec{
+								Container: &model.ContainerCallSpec{
+									Cmd: []interface{}{"$(id)", "$(outcome)", "$(error)"},
+								},
+							},
+						},
+						Finally: &model.CallSpec{
+							Container: &model.ContainerCallSpec{
+								Cmd: []interface{}{"$(id)", "$(outcome)"},
+							},
+						},
+					},
+				)
+
+				/* assert */
+				Expect(actualErrs).To(BeEmpty())
+			})
+		})
+	})
+	Context("failFast on non parallel call", func() {
+		It("should return expected error", func() {
+			/* arrange */
+			failFast := false
+
+			/* act */
+			actualErrs := Validate(
+				context.Background(),
+				wd,
+				&model.OpSpec{
+					Run: &model.CallSpec{
+						FailFast: &failFast,
+						Serial:   &[]*model.CallSpec{},
+					},
+				},
+			)
+
+			/* assert */
+			Expect(actualErrs).To(ConsistOf(MatchError("run.failFast: only applies to parallel calls; use parallelLoop.failFast for parallelLoop calls")))
+		})
+	})
+	Context("else without if", func() {
+		It("should return expected error", func() {
+			/* act */
+			actualErrs := Validate(
+				context.Background(),
+				wd,
+				&model.OpSpec{
+					Run: &model.CallSpec{
+						Else:   &model.CallSpec{Serial: &[]*model.CallSpec{}},
+						Serial: &[]*model.CallSpec{},
+					},
+				},
+			)
+
+			/* assert */
+			Expect(actualErrs).To(ConsistOf(MatchError("run.else: requires if")))
+		})
+	})
+	Context("switch", func() {
+		Context("case invalid", func() {
+			It("should return expected errors", func() {
+				/* act */
+				actualErrs := Validate(
+					context.Background(),
+					wd,
+					&model.OpSpec{
+						Run: &model.CallSpec{
+							Switch: &model.SwitchCallSpec{
+								Cases: []*model.SwitchCaseSpec{
+									{
+										If: []*model.PredicateSpec{
+											{Exists: new(string)},
+										},
+										Run: model.CallSpec{
+											Container: &model.ContainerCallSpec{
+												Cmd: []interface{}{"$(undefined)"},
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				)
+
+				/* assert */
+				Expect(actualErrs).To(HaveLen(1))
+				Expect(actualErrs[0].Error()).To(HavePrefix("run.switch.cases[0].run.container.cmd[0]: unable to resolve '$(undefined)'"))
+			})
+		})
+		Context("outputs of cases & default referenced", func() {
+			It("should return no errors", func() {
+				/* arrange */
+				caseValue := "$(case)"
+
+				/* act */
+				actualErrs := Validate(
+					context.Background(),
+					wd,
+					&model.OpSpec{
+						Run: &model.CallSpec{
+							Serial: &[]*model.CallSpec{
+								{
+									Switch: &model.SwitchCallSpec{
+										Cases: []*model.SwitchCaseSpec{
+											{
+												If: []*model.PredicateSpec{
+													{Eq: &[]interface{}{caseValue, "a"}},
+												},
+												Run: model.CallSpec{
+													Container: &model.ContainerCallSpec{
+														Files: map[string]interface{}{"/a": "$(a)"},
+													},
+												},
+											},
+										},
+										Default: &model.CallSpec{
+											Container: &model.ContainerCallSpec{
+												Files: map[string]interface{}{"/b": "$(b)"},
+											},
+										},
+									},
+								},
+								{
+									Container: &model.ContainerCallSpec{
+										Cmd: []interface{}{"$(a)", "$(b)"},
+									},
+								},
+							},
+						},
+						Inputs: map[string]*model.Param{
+							"case": {String: &model.StringParam{}},
+						},
+					},
+				)
+
+				/* assert */
+				Expect(actualErrs).To(BeEmpty())
+			})
+		})
+	})
+	Context("container mounts", func() {
+		Context("identifier ref mounted", func() {
+			It("should be output", func() {
+				/* act */
+				actualErrs := Validate(
+					context.Background(),
+					wd,
+					&model.OpSpec{
+						Run: &model.CallSpec{
+							SerialLoop: &model.SerialLoopCallSpec{
+								Collect: []string{"$(srcDir)"},
+								Range:   []interface{}{1, 2},
+								Run: model.CallSpec{
+									Container: &model.ContainerCallSpec{
+										Dirs: map[string]interface{}{
+											"/src": "$(srcDir)",
+										},
+									},
+								},
+							},
+						},
+					},
+				)
+
+				/* assert */
+				Expect(actualErrs).To(BeEmpty())
+			})
+		})
+		Context("op fs ref & path of identifier ref mounted", func() {
+			It("should not be output", func() {
+				/* act */
+				actualErrs := Validate(
+					context.Background(),
+					wd,
+					&model.OpSpec{
+						Inputs: map[string]*model.Param{
+							"srcDir": {Dir: &model.DirParam{}},
+						},
+						Run: &model.CallSpec{
+							SerialLoop: &model.SerialLoopCallSpec{
+								Collect: []string{"$(/)", "$(srcDir/file.txt)"},
+								Range:   []interface{}{1, 2},
+								Run: model.CallSpec{
+									Container: &model.ContainerCallSpec{
+										Dirs: map[string]interface{}{
+											"/src": "$(/)",
+										},
+										Files: map[string]interface{}{
+											"/file.txt": "$(srcDir/file.txt)",
+										},
+									},
+								},
+							},
+						},
+					},
+				)
+
+				/* assert */
+				Expect(actualErrs).To(ConsistOf(
+					MatchError("run.serialLoop.collect: '/' never output by run"),
+					MatchError("run.serialLoop.collect: 'srcDir/file.txt' never output by run"),
+				))
+			})
+		})
+	})
+	Context("loop collect", func() {
+		Context("output not output by run", func() {
+			It("should return expected error", func() {
+				/* act */
+				actualErrs := Validate(
+					context.Background(),
+					wd,
+					&model.OpSpec{
+						Run: &model.CallSpec{
+							SerialLoop: &model.SerialLoopCallSpec{
+								Collect: []string{"$(undefined)"},
+								Range:   []interface{}{1, 2},
+								Run: model.CallSpec{
+									Container: &model.ContainerCallSpec{},
+								},
+							},
+						},
+					},
+				)
+
+				/* assert */
+				Expect(actualErrs).To(ConsistOf(MatchError("run.serialLoop.collect: 'undefined' never output by run")))
+			})
+		})
+		Context("collected output referenced", func() {
+			It("should return no errors", func() {
+				/* act */
+				actualErrs := Validate(
+					context.Background(),
+					wd,
+					&model.OpSpec{
+						Run: &model.CallSpec{
+							Serial: &[]*model.CallSpec{
+								{
+									ParallelLoop: &model.ParallelLoopCallSpec{
+										Collect: []string{"$(digest)"},
+										Range:   []interface{}{1, 2},
+										Run: model.CallSpec{
+											Container: &model.ContainerCallSpec{
+												Files: map[string]interface{}{"/digest": "$(digest)"},
+											},
+										},
+									},
+								},
+								{
+									Container: &model.ContainerCallSpec{
+										Cmd: []interface{}{"$(digest)"},
+									},
+								},
+							},
+						},
+					},
+				)
+
+				/* assert */
+				Expect(actualErrs).To(BeEmpty())
+			})
+		})
+	})
+	Context("dag", func() {
+		newContainerCallSpec := func(name string, needs []string, cmd string, outputFile string) *model.CallSpec {
+			return &model.CallSpec{
+				Name:  &name,
+				Needs: needs,
+				Container: &model.ContainerCallSpec{
+					Cmd:   []interface{}{cmd},
+					Files: map[string]interface{}{"/output": fmt.Sprintf("$(%v)", outputFile)},
+				},
+			}
+		}
+
+		Context("child refs outputs of needed call", func() {
+			It("should return no errors", func() {
+				/* act */
+				actualErrs := Validate(
+					context.Background(),
+					wd,
+					&model.OpSpec{
+						Run: &model.CallSpec{
+							Dag: &[]*model.CallSpec{
+								newContainerCallSpec("c", []string{"b"}, "$(aOutput) $(bOutput)", "cOutput"),
+								newContainerCallSpec("b", []string{"a"}, "$(aOutput)", "bOutput"),
+								newContainerCallSpec("a", nil, "a", "aOutput"),
+							},
+						},
+					},
+				)
+
+				/* assert */
+				Expect(actualErrs).To(BeEmpty())
+			})
+		})
+		Context("child refs outputs of call it doesn't need", func() {
+			It("should return expected error", func() {
+				/* act */
+				actualErrs := Validate(
+					context.Background(),
+					wd,
+					&model.OpSpec{
+						Run: &model.CallSpec{
+							Dag: &[]*model.CallSpec{
+								newContainerCallSpec("a", nil, "a", "aOutput"),
+								newContainerCallSpec("b", nil, "$(aOutput)", "bOutput"),
+							},
+						},
+					},
+				)
+
+				/* assert */
+				Expect(actualErrs).To(ConsistOf(MatchError("run.dag[1].container.cmd[0]: unable to resolve '$(aOutput)': 'aOutput' not in scope")))
+			})
+		})
+		Context("needs form a cycle", func() {
+			It("should return expected error", func() {
+				/* act */
+				actualErrs := Validate(
+					context.Background(),
+					wd,
+					&model.OpSpec{
+						Run: &model.CallSpec{
+							Dag: &[]*model.CallSpec{
+								newContainerCallSpec("a", []string{"b"}, "a", "aOutput"),
+								newContainerCallSpec("b", []string{"a"}, "b", "bOutput"),
+							},
+						},
+					},
+				)
+
+				/* assert */
+				Expect(actualErrs).To(ConsistOf(MatchError("run.dag: unable to interpret needs: needs form a cycle 'a -> b -> a'")))
+			})
+		})
+	})
+	Context("serialLoop vars used after loop", func() {
+		It("should return expected error", func() {
+			/* arrange */
+			index := "$(index)"
+
+			/* act */
+			actualErrs := Validate(
+				context.Background(),
+				wd,
+				&model.OpSpec{
+					Run: &model.CallSpec{
+						Serial: &[]*model.CallSpec{
+							{
+								SerialLoop: &model.SerialLoopCallSpec{
+									Range: []interface{}{1, 2},
+									Vars:  &model.LoopVarsSpec{Index: &index},
+									Run: model.CallSpec{
+										Container: &model.ContainerCallSpec{
+											Cmd: []interface{}{"$(index)"},
+										},
+									},
+								},
+							},
+							{
+								Container: &model.ContainerCallSpec{
+									Cmd: []interface{}{"$(index)"},
+								},
+							},
+						},
+					},
+				},
+			)
+
+			/* assert */
+			Expect(actualErrs).To(ConsistOf(MatchError("run.serial[1].container.cmd[0]: unable to resolve '$(index)': 'index' not in scope")))
+		})
+	})
+})
+
+var _ = Context("ValidateRecursive", func() {
+	wd, err := os.Getwd()
+	if err != nil {
+		panic(err)
+	}
+
+	Context("child op invalid", func() {
+		It("should return child op errors once", func() {
+			/* arrange */
+			opPath := filepath.Join(wd, "testdata/recursive")
+			opFile, err := opfile.Get(context.Background(), opPath)
+			if err != nil {
+				panic(err)
+			}
+
+			/* act */
+			actualErrs := ValidateRecursive(
+				context.Background(),
+				opPath,
+				opFile,
+			)
+
+			/* assert */
+			Expect(actualErrs).To(ConsistOf(
+				MatchError("run.serial[0].op: ../brokenchild: run.container.cmd[0]: unable to resolve '$(missing)': 'missing' not in scope"),
+			))
+		})
+	})
+	Context("remote child op not resolvable by providers", func() {
+		It("should return expected error", func() {
+			/* act */
+			actualErrs := ValidateRecursive(
+				context.Background(),
+				wd,
+				&model.OpSpec{
+					Run: &model.CallSpec{
+						Op: &model.OpCallSpec{
+							Ref: "host/path#1.0.0",
+						},
+					},
+				},
+				fs.New(),
+			)
+
+			/* assert */
+			Expect(actualErrs).To(HaveLen(1))
+			Expect(actualErrs[0].Error()).To(HavePrefix("run.op.ref: unable to resolve op 'host/path#1.0.0'"))
+		})
+	})
+})
@@ -19,6 +19,10 @@ type EventStore interface {
 		<-chan model.Event,
 		<-chan error,
 	)
+	// Rewrite calls rewrite w/ each event in the order they were added; events rewrite reports as rewritten are replaced
+	Rewrite(
+		rewrite func(event model.Event) (model.Event, bool, error),
+	) error
 }
 
 const sortableRFC3339Nano = "2006-01-02T15:04:05.000000000Z07:00"
@@ -104,3 +108,57 @@ func (es _eventStore) List(
 
 	return eventChannel, errChannel
 }
+
+// O(n) (n being number of events that exist); threadsafe
+func (es *_eventStore) Rewrite(
+	rewrite func(event model.Event) (model.Event, bool, error),
+) error {
+	keys := [][]byte{}
+	rewrittenEvents := []model.Event{}
+	err := es.db.View(func(txn *badger.Txn) error {
+		it := txn.NewIterator(badger.DefaultIteratorOptions)
+		defer it.Close()
+		prefixBytes := []byte(es.eventsByTimestampKeyPrefix)
+		for it.Seek(prefixBytes); it.ValidForPrefix(prefixBytes); it.Next() {
+			item := it.Item()
+			if err := item.Value(func(v []byte) error {
+				event := model.Event{}
+				if err := json.Unmarshal(v, &event); err != nil {
+					return err
+				}
+
+				rewrittenEvent, isRewritten, err := rewrite(event)
+				if err != nil {
+					return err
+				}
+
+				if isRewritten {
+					keys = append(keys, item.KeyCopy(nil))
+					rewrittenEvents = append(rewrittenEvents, rewrittenEvent)
+				}
+				return nil
+			}); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	for i, rewrittenEvent := range rewrittenEvents {
+		encodedEvent, err := json.Marshal(rewrittenEvent)
+		if err != nil {
+			return err
+		}
+
+		if err := es.db.Update(func(txn *badger.Txn) error {
+			return txn.Set(keys[i], encodedEvent)
+		}); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -15,6 +15,17 @@ type FakePubSub struct {
 	publishArgsForCall []struct {
 		arg1 model.Event
 	}
+	RewriteEventsStub        func(func(model.Event) (model.Event, bool, error)) error
+	rewriteEventsMutex       sync.RWMutex
+	rewriteEventsArgsForCall []struct {
+		arg1 func(model.Event) (model.Event, bool, error)
+	}
+	rewriteEventsReturns struct {
+		result1 error
+	}
+	rewriteEventsReturnsOnCall map[int]struct {
+		result1 error
+	}
 	SubscribeStub        func(context.Context, model.EventFilter) (<-chan model.Event, error)
 	subscribeMutex       sync.RWMutex
 	subscribeArgsForCall []struct {
@@ -64,6 +75,66 @@ func (fake *FakePubSub) PublishArgsForCall(i int) model.Event {
 	return argsForCall.arg1
 }
 
+func (fake *FakePubSub) RewriteEvents(arg1 func(model.Event) (model.Event, bool, error)) error {
+	fake.rewriteEventsMutex.Lock()
+	ret, specificReturn := fake.rewriteEventsReturnsOnCall[len(fake.rewriteEventsArgsForCall)]
+	fake.rewriteEventsArgsForCall = append(fake.rewriteEventsArgsForCall, struct {
+		arg1 func(model.Event) (model.Event, bool, error)
+	}{arg1})
+	fake.recordInvocation("RewriteEvents", []interface{}{arg1})
+	fake.rewriteEventsMutex.Unlock()
+	if fake.RewriteEventsStub != nil {
+		return fake.RewriteEventsStub(arg1)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.rewriteEventsReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakePubSub) RewriteEventsCallCount() int {
+	fake.rewriteEventsMutex.RLock()
+	defer fake.rewriteEventsMutex.RUnlock()
+	return len(fake.rewriteEventsArgsForCall)
+}
+
+func (fake *FakePubSub) RewriteEventsCalls(stub func(func(model.Event) (model.Event, bool, error)) error) {
+	fake.rewriteEventsMutex.Lock()
+	defer fake.rewriteEventsMutex.Unlock()
+	fake.RewriteEventsStub = stub
+}
+
+func (fake *FakePubSub) RewriteEventsArgsForCall(i int) func(model.Event) (model.Event, bool, error) {
+	fake.rewriteEventsMutex.RLock()
+	defer fake.rewriteEventsMutex.RUnlock()
+	argsForCall := fake.rewriteEventsArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakePubSub) RewriteEventsReturns(result1 error) {
+	fake.rewriteEventsMutex.Lock()
+	defer fake.rewriteEventsMutex.Unlock()
+	fake.RewriteEventsStub = nil
+	fake.rewriteEventsReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakePubSub) RewriteEventsReturnsOnCall(i int, result1 error) {
+	fake.rewriteEventsMutex.Lock()
+	defer fake.rewriteEventsMutex.Unlock()
+	fake.RewriteEventsStub = nil
+	if fake.rewriteEventsReturnsOnCall == nil {
+		fake.rewriteEventsReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.rewriteEventsReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
 func (fake *FakePubSub) Subscribe(arg1 context.Context, arg2 model.EventFilter) (<-chan model.Event, error) {
 	fake.subscribeMutex.Lock()
 	ret, specificReturn := fake.subscribeReturnsOnCall[len(fake.subscribeArgsForCall)]
@@ -133,6 +204,8 @@ func (fake *FakePubSub) Invocations() map[string][][]interface{} {
 	defer fake.invocationsMutex.RUnlock()
 	fake.publishMutex.RLock()
 	defer fake.publishMutex.RUnlock()
+	fake.rewriteEventsMutex.RLock()
+	defer fake.rewriteEventsMutex.RUnlock()
 	fake.subscribeMutex.RLock()
 	defer fake.subscribeMutex.RUnlock()
 	copiedInvocations := map[string][][]interface{}{}
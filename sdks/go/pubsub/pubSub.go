@@ -31,9 +31,19 @@ type EventSubscriber interface {
 	)
 }
 
+//counterfeiter:generate -o fakes/eventRewriter.go . EventRewriter
+type EventRewriter interface {
+	// RewriteEvents calls rewrite w/ each stored event in the order they were added;
+	// events rewrite reports as rewritten are replaced (i.e. to migrate events stored by prior versions).
+	RewriteEvents(
+		rewrite func(event model.Event) (model.Event, bool, error),
+	) error
+}
+
 //counterfeiter:generate -o fakes/pubSub.go . PubSub
 type PubSub interface {
 	EventPublisher
+	EventRewriter
 	EventSubscriber
 }
 
@@ -101,6 +111,12 @@ func (ps *pubSub) Subscribe(
 	return dstEventChannel, nil
 }
 
+func (ps *pubSub) RewriteEvents(
+	rewrite func(event model.Event) (model.Event, bool, error),
+) error {
+	return ps.eventStore.Rewrite(rewrite)
+}
+
 func (ps *pubSub) gcSubscription(
 	channel chan model.Event,
 ) {
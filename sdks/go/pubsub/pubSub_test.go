@@ -195,4 +195,61 @@ var _ = Context("pubSub", func() {
 			})
 		})
 	})
+	Context("RewriteEvents", func() {
+		It("should replace rewritten events only", func() {
+			/* arrange */
+			db.DropAll()
+
+			keptEvent := model.Event{
+				CallStarted: &model.CallStarted{
+					Call: model.Call{
+						ID: "keptID",
+					},
+				},
+				Timestamp: time.Now().UTC(),
+			}
+
+			rewrittenEvent := model.Event{
+				CallStarted: &model.CallStarted{
+					Call: model.Call{
+						ID: "rewrittenID",
+					},
+				},
+				Timestamp: time.Now().UTC().Add(time.Second),
+			}
+
+			objectUnderTest := New(db)
+			objectUnderTest.Publish(keptEvent)
+			objectUnderTest.Publish(rewrittenEvent)
+
+			/* act */
+			actualIDs := []string{}
+			actualErr := objectUnderTest.RewriteEvents(
+				func(event model.Event) (model.Event, bool, error) {
+					actualIDs = append(actualIDs, event.CallStarted.Call.ID)
+					if event.CallStarted.Call.ID != rewrittenEvent.CallStarted.Call.ID {
+						return event, false, nil
+					}
+
+					event.CallStarted.Call.ID = "newID"
+					return event, true, nil
+				},
+			)
+
+			/* assert */
+			Expect(actualErr).To(BeNil())
+			// called in the order events were added
+			Expect(actualIDs).To(Equal([]string{"keptID", "rewrittenID"}))
+
+			eventChannel, _ := objectUnderTest.Subscribe(context.TODO(), model.EventFilter{})
+
+			var actualEvent1 model.Event
+			Eventually(eventChannel).Should(Receive(&actualEvent1))
+			Expect(actualEvent1.CallStarted.Call.ID).To(Equal("keptID"))
+
+			var actualEvent2 model.Event
+			Eventually(eventChannel).Should(Receive(&actualEvent2))
+			Expect(actualEvent2.CallStarted.Call.ID).To(Equal("newID"))
+		})
+	})
 })
@@ -71,6 +71,7 @@ export type CallSerial = Call[]
 
 export interface Call {
   container?: ContainerCall
+  dag?: CallParallel
   if?: Predicate[]
   name?: string
   needs?: string[]
@@ -100,6 +101,11 @@ export default function HasCall(
       opCall={call.op}
       parentOpRef={parentOpRef}
     />
+  } else if (call.dag) {
+    callComponent = <CallHasParallel
+      callParallel={call.dag}
+      parentOpRef={parentOpRef}
+    />
   } else if (call.parallel) {
     callComponent = <CallHasParallel
       callParallel={call.parallel}
@@ -131,7 +137,7 @@ export default function HasCall(
         display: 'flex',
         justifyContent: 'center',
         flexDirection: 'column',
-        ...!(call.container || call.serial || call.parallel)
+        ...!(call.container || call.serial || call.parallel || call.dag)
           ? {
             border: `solid .1rem ${brandColors.lightGray}`
           }
@@ -138,6 +138,8 @@ module.exports = {
               items: [
                 "reference/cli/auth/index",
                 "reference/cli/auth/add",
+                "reference/cli/auth/ls",
+                "reference/cli/auth/rm",
               ]
             },
             "reference/cli/events",
@@ -149,6 +151,7 @@ module.exports = {
                 "reference/cli/node/index",
                 "reference/cli/node/create",
                 "reference/cli/node/kill",
+                "reference/cli/node/rotate-key",
               ]
             },
             {
@@ -156,9 +159,24 @@ module.exports = {
               label: "op",
               items: [
                 "reference/cli/op/index",
+                {
+                  type: "category",
+                  label: "cache",
+                  items: [
+                    "reference/cli/op/cache/index",
+                    "reference/cli/op/cache/ls",
+                    "reference/cli/op/cache/prune",
+                    "reference/cli/op/cache/rm",
+                  ]
+                },
                 "reference/cli/op/create",
+                "reference/cli/op/fmt",
+                "reference/cli/op/graph",
                 "reference/cli/op/install",
                 "reference/cli/op/kill",
+                "reference/cli/op/lint",
+                "reference/cli/op/publish",
+                "reference/cli/op/sign",
                 "reference/cli/op/validate",
               ]
             },